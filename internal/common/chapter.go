@@ -0,0 +1,17 @@
+// Package common holds small helpers shared between the chapters.
+package common
+
+import (
+	"fmt"
+	"io"
+	"os"
+)
+
+// output is where chapter announcements are written, swapped out in tests.
+var output io.Writer = os.Stdout
+
+// AnnounceChapter prints a banner marking the start of a chapter, in the
+// same style as the section headers used throughout the chapters.
+func AnnounceChapter(name string) {
+	fmt.Fprintf(output, "----- [%s] -----\n", name)
+}
@@ -0,0 +1,18 @@
+package common
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnnounceChapter(t *testing.T) {
+	var buffer bytes.Buffer
+	original := output
+	output = &buffer
+	defer func() { output = original }()
+
+	AnnounceChapter("Pointers")
+	assert.Equal(t, buffer.String(), "----- [Pointers] -----\n")
+}
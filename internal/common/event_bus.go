@@ -0,0 +1,67 @@
+package common
+
+import "sync"
+
+// EventBus fans each published event out to every current subscriber.
+//
+// Delivery policy: every subscriber has its own buffered channel.  Publish
+// never blocks, if a subscribers buffer is full the event is dropped for that
+// subscriber only.  A slow subscriber therefore misses events rather than
+// stalling the publisher or the other subscribers.
+type EventBus[T any] struct {
+	mu          sync.Mutex
+	buffer      int
+	subscribers []chan T
+	closed      bool
+}
+
+// NewEventBus returns an EventBus whose subscriber channels buffer up to
+// `buffer` undelivered events each.
+func NewEventBus[T any](buffer int) *EventBus[T] {
+	return &EventBus[T]{buffer: buffer}
+}
+
+// Subscribe returns a channel receiving every event published from now on.
+// The channel is closed when the bus is closed, subscribing to an already
+// closed bus returns a closed channel.
+func (b *EventBus[T]) Subscribe() <-chan T {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	ch := make(chan T, b.buffer)
+	if b.closed {
+		close(ch)
+		return ch
+	}
+	b.subscribers = append(b.subscribers, ch)
+	return ch
+}
+
+// Publish delivers event to every subscriber with room in its buffer.
+// Publishing to a closed bus is a no-op.
+func (b *EventBus[T]) Publish(event T) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		return
+	}
+	for _, ch := range b.subscribers {
+		select {
+		case ch <- event:
+		default:
+		}
+	}
+}
+
+// Close closes every subscriber channel.  It is safe to call more than once.
+func (b *EventBus[T]) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		return
+	}
+	b.closed = true
+	for _, ch := range b.subscribers {
+		close(ch)
+	}
+	b.subscribers = nil
+}
@@ -0,0 +1,82 @@
+package common
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventBusFansOutToSubscribers(t *testing.T) {
+	bus := NewEventBus[string](1)
+	subscribers := []<-chan string{bus.Subscribe(), bus.Subscribe(), bus.Subscribe()}
+
+	var wg sync.WaitGroup
+	received := make([]string, len(subscribers))
+	for i, sub := range subscribers {
+		wg.Add(1)
+		go func(i int, sub <-chan string) {
+			defer wg.Done()
+			received[i] = <-sub
+		}(i, sub)
+	}
+	bus.Publish("hello")
+	wg.Wait()
+	assert.Equal(t, received, []string{"hello", "hello", "hello"})
+}
+
+func TestEventBusCloseClosesSubscribers(t *testing.T) {
+	bus := NewEventBus[int](0)
+	first, second := bus.Subscribe(), bus.Subscribe()
+	bus.Close()
+	bus.Close()
+
+	_, ok := <-first
+	assert.False(t, ok)
+	_, ok = <-second
+	assert.False(t, ok)
+
+	// Late subscribers get a closed channel, publishing is a no-op.
+	_, ok = <-bus.Subscribe()
+	assert.False(t, ok)
+	assert.NotPanics(t, func() { bus.Publish(1) })
+}
+
+func TestEventBusDropsForSlowSubscribers(t *testing.T) {
+	bus := NewEventBus[int](2)
+	slow := bus.Subscribe()
+	for i := 0; i < 5; i++ {
+		bus.Publish(i)
+	}
+	bus.Close()
+
+	var received []int
+	for v := range slow {
+		received = append(received, v)
+	}
+	// Only the events that fit into the buffer were kept.
+	assert.Equal(t, received, []int{0, 1})
+}
+
+func TestEventBusConcurrentPublishers(t *testing.T) {
+	bus := NewEventBus[int](100)
+	sub := bus.Subscribe()
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 10; j++ {
+				bus.Publish(i*10 + j)
+			}
+		}(i)
+	}
+	wg.Wait()
+	bus.Close()
+
+	count := 0
+	for range sub {
+		count++
+	}
+	assert.Equal(t, count, 100)
+}
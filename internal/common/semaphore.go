@@ -0,0 +1,40 @@
+package common
+
+import "context"
+
+// Semaphore bounds the number of goroutines that can hold it at once.  It is
+// backed by a buffered channel, sending claims one of the n slots and
+// receiving frees it again, so a full channel blocks further acquires.
+type Semaphore struct {
+	slots chan struct{}
+}
+
+// New returns a Semaphore allowing up to n concurrent holders.  With no slots
+// every Acquire would block forever, so n below 1 panics.
+func New(n int) *Semaphore {
+	if n < 1 {
+		panic("common: Semaphore needs at least one slot")
+	}
+	return &Semaphore{slots: make(chan struct{}, n)}
+}
+
+// Acquire claims a slot, blocking until one is free or ctx is done.  If ctx
+// finishes first its error is returned and no slot is held.
+func (s *Semaphore) Acquire(ctx context.Context) error {
+	select {
+	case s.slots <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Release frees a slot claimed by Acquire.  Releasing more than was acquired
+// is a programming error and panics.
+func (s *Semaphore) Release() {
+	select {
+	case <-s.slots:
+	default:
+		panic("common: Semaphore released more than acquired")
+	}
+}
@@ -0,0 +1,60 @@
+package common
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSemaphoreAcquireUpToCapacity(t *testing.T) {
+	s := New(3)
+	for i := 0; i < 3; i++ {
+		assert.NoError(t, s.Acquire(context.Background()))
+	}
+}
+
+func TestSemaphoreBlocksBeyondCapacity(t *testing.T) {
+	s := New(1)
+	assert.NoError(t, s.Acquire(context.Background()))
+
+	acquired := make(chan struct{})
+	go func() {
+		assert.NoError(t, s.Acquire(context.Background()))
+		close(acquired)
+	}()
+
+	select {
+	case <-acquired:
+		t.Fatal("acquired beyond capacity")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	// Releasing the held slot unblocks the waiter.
+	s.Release()
+	select {
+	case <-acquired:
+	case <-time.After(time.Second):
+		t.Fatal("waiter was not unblocked by release")
+	}
+}
+
+func TestSemaphoreAcquireCancelled(t *testing.T) {
+	s := New(1)
+	assert.NoError(t, s.Acquire(context.Background()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, s.Acquire(ctx), context.DeadlineExceeded)
+}
+
+func TestSemaphoreOverReleasePanics(t *testing.T) {
+	s := New(1)
+	assert.Panics(t, s.Release)
+}
+
+func TestSemaphoreInvalidSizePanics(t *testing.T) {
+	assert.Panics(t, func() { New(0) })
+	assert.Panics(t, func() { New(-1) })
+}
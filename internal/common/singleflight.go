@@ -0,0 +1,66 @@
+package common
+
+import (
+	"errors"
+	"sync"
+)
+
+// ErrCallPanicked is returned to callers that were waiting on a call whose fn
+// panicked.  The panic itself is only raised in the goroutine that ran fn.
+var ErrCallPanicked = errors.New("common: Group call panicked")
+
+// call is an in-flight (or completed) Do call for a single key.
+type call[V any] struct {
+	wg   sync.WaitGroup
+	val  V
+	err  error
+	dups int
+}
+
+// Group coalesces concurrent calls for the same key, so that only one of them
+// does the work and the rest wait for, and share, its result.  This is useful
+// in front of expensive lookups, a cache miss hit by a thousand requests at once
+// only needs to go to the database once.  The zero value is ready to use.
+type Group[K comparable, V any] struct {
+	mu    sync.Mutex
+	calls map[K]*call[V]
+}
+
+// Do runs fn for key and returns its result.  If a call for key is already in
+// flight, Do waits for it to finish and returns the same result instead of
+// calling fn again.  Once a call completes the key is forgotten, so a later Do
+// will run fn afresh.  If fn panics the panic carries on up through this Do,
+// while any waiters are released with ErrCallPanicked.
+func (g *Group[K, V]) Do(key K, fn func() (V, error)) (V, error) {
+	g.mu.Lock()
+	if g.calls == nil {
+		g.calls = make(map[K]*call[V])
+	}
+	if c, ok := g.calls[key]; ok {
+		c.dups++
+		g.mu.Unlock()
+		c.wg.Wait()
+		return c.val, c.err
+	}
+	c := new(call[V])
+	c.wg.Add(1)
+	g.calls[key] = c
+	g.mu.Unlock()
+
+	// Releasing the waiters and forgetting the key are deferred so they still
+	// happen if fn panics, otherwise every caller for key would block forever.
+	normalReturn := false
+	defer func() {
+		if !normalReturn {
+			c.err = ErrCallPanicked
+		}
+		c.wg.Done()
+		g.mu.Lock()
+		delete(g.calls, key)
+		g.mu.Unlock()
+	}()
+
+	c.val, c.err = fn()
+	normalReturn = true
+	return c.val, c.err
+}
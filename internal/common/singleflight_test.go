@@ -0,0 +1,115 @@
+package common
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGroupCoalescesConcurrentCalls(t *testing.T) {
+	const callers = 50
+	var g Group[string, int]
+	var runs atomic.Int32
+	release := make(chan struct{})
+
+	fn := func() (int, error) {
+		runs.Add(1)
+		<-release
+		return 42, nil
+	}
+
+	var wg sync.WaitGroup
+	results := make([]int, callers)
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			v, err := g.Do("key", fn)
+			assert.NoError(t, err)
+			results[i] = v
+		}(i)
+	}
+
+	// Hold fn open until every other caller is waiting on it.
+	assert.Eventually(t, func() bool {
+		g.mu.Lock()
+		defer g.mu.Unlock()
+		c, ok := g.calls["key"]
+		return ok && c.dups == callers-1
+	}, time.Second, time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, runs.Load(), int32(1))
+	for _, v := range results {
+		assert.Equal(t, v, 42)
+	}
+}
+
+func TestGroupSharesErrors(t *testing.T) {
+	var g Group[int, string]
+	boom := errors.New("boom")
+	_, err := g.Do(1, func() (string, error) { return "", boom })
+	assert.ErrorIs(t, err, boom)
+}
+
+func TestGroupForgetsCompletedCalls(t *testing.T) {
+	var g Group[string, int]
+	var runs int
+	fn := func() (int, error) {
+		runs++
+		return runs, nil
+	}
+	first, _ := g.Do("key", fn)
+	second, _ := g.Do("key", fn)
+	assert.Equal(t, first, 1)
+	assert.Equal(t, second, 2)
+}
+
+func TestGroupSurvivesPanic(t *testing.T) {
+	var g Group[string, int]
+	release := make(chan struct{})
+	waiterErr := make(chan error, 1)
+
+	go func() {
+		defer func() { _ = recover() }()
+		_, _ = g.Do("key", func() (int, error) {
+			<-release
+			panic("boom")
+		})
+	}()
+
+	// Wait for the panicking call to be in flight, then join it.
+	assert.Eventually(t, func() bool {
+		g.mu.Lock()
+		defer g.mu.Unlock()
+		_, ok := g.calls["key"]
+		return ok
+	}, time.Second, time.Millisecond)
+	go func() {
+		_, err := g.Do("key", func() (int, error) { return 0, nil })
+		waiterErr <- err
+	}()
+	assert.Eventually(t, func() bool {
+		g.mu.Lock()
+		defer g.mu.Unlock()
+		return g.calls["key"].dups == 1
+	}, time.Second, time.Millisecond)
+	close(release)
+
+	select {
+	case err := <-waiterErr:
+		assert.ErrorIs(t, err, ErrCallPanicked)
+	case <-time.After(time.Second):
+		t.Fatal("waiter blocked after fn panicked")
+	}
+
+	// The key was forgotten, so a later call runs fn again.
+	v, err := g.Do("key", func() (int, error) { return 7, nil })
+	assert.NoError(t, err)
+	assert.Equal(t, v, 7)
+}
@@ -0,0 +1,57 @@
+package common
+
+import (
+	"sync"
+	"time"
+)
+
+// Debounce returns a function that delays calling f until d has elapsed since
+// it was last invoked.  A burst of rapid calls collapses into a single call of
+// f, made d after the final call in the burst.  f runs on its own goroutine.
+// The returned function is safe for concurrent use.
+func Debounce(d time.Duration, f func()) func() {
+	return debounce(d, f, func(d time.Duration, f func()) resetter {
+		return time.AfterFunc(d, f)
+	})
+}
+
+// resetter is the part of *time.Timer used by debounce.
+type resetter interface {
+	Reset(d time.Duration) bool
+}
+
+// debounce implements Debounce with the timer creation injected, so tests can
+// swap in a timer they fire by hand instead of depending on the wall clock.
+func debounce(d time.Duration, f func(), afterFunc func(time.Duration, func()) resetter) func() {
+	var mu sync.Mutex
+	var timer resetter
+	return func() {
+		mu.Lock()
+		defer mu.Unlock()
+		if timer == nil {
+			timer = afterFunc(d, f)
+			return
+		}
+		timer.Reset(d)
+	}
+}
+
+// Throttle returns a function that calls f at most once per d.  The first call
+// runs f immediately, any further calls within d of that are dropped rather
+// than queued.  f runs on the callers goroutine.  The returned function is safe
+// for concurrent use.
+func Throttle(d time.Duration, f func()) func() {
+	var mu sync.Mutex
+	var last time.Time
+	return func() {
+		mu.Lock()
+		now := time.Now()
+		if !last.IsZero() && now.Sub(last) < d {
+			mu.Unlock()
+			return
+		}
+		last = now
+		mu.Unlock()
+		f()
+	}
+}
@@ -0,0 +1,102 @@
+package common
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeTimer stands in for a *time.Timer, it only fires when told to.
+type fakeTimer struct {
+	f      func()
+	armed  bool
+	resets int
+}
+
+func (ft *fakeTimer) Reset(time.Duration) bool {
+	wasArmed := ft.armed
+	ft.armed = true
+	ft.resets++
+	return wasArmed
+}
+
+// fire simulates d elapsing, running f if the timer is armed.
+func (ft *fakeTimer) fire() {
+	if ft.armed {
+		ft.armed = false
+		ft.f()
+	}
+}
+
+// fakeDebounce returns a debounced f using a fakeTimer, which is created on
+// the first call.
+func fakeDebounce(f func()) (func(), func() *fakeTimer) {
+	var timer *fakeTimer
+	debounced := debounce(time.Second, f, func(_ time.Duration, f func()) resetter {
+		timer = &fakeTimer{f: f, armed: true}
+		return timer
+	})
+	return debounced, func() *fakeTimer { return timer }
+}
+
+func TestDebounceCoalescesRapidCalls(t *testing.T) {
+	var calls int
+	debounced, timer := fakeDebounce(func() { calls++ })
+
+	for i := 0; i < 10; i++ {
+		debounced()
+	}
+	// Every call after the first pushed the deadline back, nothing has fired.
+	assert.Equal(t, timer().resets, 9)
+	assert.Equal(t, calls, 0)
+
+	timer().fire()
+	assert.Equal(t, calls, 1)
+	// Firing again without a new call does nothing.
+	timer().fire()
+	assert.Equal(t, calls, 1)
+}
+
+func TestDebounceSpacedCallsFireAgain(t *testing.T) {
+	var calls int
+	debounced, timer := fakeDebounce(func() { calls++ })
+
+	debounced()
+	timer().fire()
+	assert.Equal(t, calls, 1)
+
+	// The same timer is re-armed by the next call.
+	debounced()
+	timer().fire()
+	assert.Equal(t, calls, 2)
+}
+
+func TestThrottleFirstCallFiresImmediately(t *testing.T) {
+	var calls atomic.Int32
+	throttled := Throttle(time.Hour, func() { calls.Add(1) })
+	throttled()
+	assert.Equal(t, calls.Load(), int32(1))
+}
+
+func TestThrottleDropsCallsWithinWindow(t *testing.T) {
+	var calls atomic.Int32
+	throttled := Throttle(time.Hour, func() { calls.Add(1) })
+	for i := 0; i < 10; i++ {
+		throttled()
+	}
+	assert.Equal(t, calls.Load(), int32(1))
+}
+
+func TestThrottleFiresAgainAfterWindow(t *testing.T) {
+	var calls atomic.Int32
+	throttled := Throttle(20*time.Millisecond, func() { calls.Add(1) })
+	throttled()
+	throttled()
+	assert.Equal(t, calls.Load(), int32(1))
+
+	time.Sleep(30 * time.Millisecond)
+	throttled()
+	assert.Equal(t, calls.Load(), int32(2))
+}
@@ -0,0 +1,43 @@
+package composite_types
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrSliceTooShort is returned when a slice has fewer elements than the array
+// it is being converted to.
+var ErrSliceTooShort = errors.New("slice too short for array")
+
+// Converting a slice to an array with [N]T(s) compiles for any slice, but
+// panics at runtime when len(s) < N.  Generics cannot (yet) be parameterised
+// over an array length, so there is no single ToArray[T, N], instead there is a
+// helper per size.  Like the conversion itself, a longer slice is allowed and
+// only its first N elements are copied into the array.
+
+// SliceToFixed2 converts s into a [2]T, returning an error rather than panicking
+// when s is too short.
+func SliceToFixed2[T any](s []T) ([2]T, error) {
+	if len(s) < 2 {
+		return [2]T{}, fmt.Errorf("%w: need 2, got %d", ErrSliceTooShort, len(s))
+	}
+	return [2]T(s), nil
+}
+
+// SliceToFixed3 converts s into a [3]T, returning an error rather than panicking
+// when s is too short.
+func SliceToFixed3[T any](s []T) ([3]T, error) {
+	if len(s) < 3 {
+		return [3]T{}, fmt.Errorf("%w: need 3, got %d", ErrSliceTooShort, len(s))
+	}
+	return [3]T(s), nil
+}
+
+// SliceToFixed4 converts s into a [4]T, returning an error rather than panicking
+// when s is too short.
+func SliceToFixed4[T any](s []T) ([4]T, error) {
+	if len(s) < 4 {
+		return [4]T{}, fmt.Errorf("%w: need 4, got %d", ErrSliceTooShort, len(s))
+	}
+	return [4]T(s), nil
+}
@@ -0,0 +1,44 @@
+package composite_types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSliceToFixedExactLength(t *testing.T) {
+	two, err := SliceToFixed2([]int{1, 2})
+	assert.NoError(t, err)
+	assert.Equal(t, two, [2]int{1, 2})
+
+	three, err := SliceToFixed3([]string{"a", "b", "c"})
+	assert.NoError(t, err)
+	assert.Equal(t, three, [3]string{"a", "b", "c"})
+
+	four, err := SliceToFixed4([]int{1, 2, 3, 4})
+	assert.NoError(t, err)
+	assert.Equal(t, four, [4]int{1, 2, 3, 4})
+}
+
+func TestSliceToFixedCopies(t *testing.T) {
+	s := []int{1, 2, 3, 4, 5}
+	arr, err := SliceToFixed4(s)
+	assert.NoError(t, err)
+	// Only the first four elements are taken, and the array is a copy.
+	assert.Equal(t, arr, [4]int{1, 2, 3, 4})
+	s[0] = 100
+	assert.Equal(t, arr[0], 1)
+}
+
+func TestSliceToFixedTooShort(t *testing.T) {
+	_, err := SliceToFixed2([]int{1})
+	assert.ErrorIs(t, err, ErrSliceTooShort)
+
+	_, err = SliceToFixed3([]int(nil))
+	assert.ErrorIs(t, err, ErrSliceTooShort)
+
+	arr, err := SliceToFixed4([]int{1, 2, 3})
+	assert.ErrorIs(t, err, ErrSliceTooShort)
+	assert.EqualError(t, err, "slice too short for array: need 4, got 3")
+	assert.Equal(t, arr, [4]int{})
+}
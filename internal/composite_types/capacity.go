@@ -0,0 +1,43 @@
+package composite_types
+
+// growthThreshold is the capacity at which append stops doubling a slice and
+// switches to a gentler growth rate.
+const growthThreshold = 256
+
+// MinCapacityFor predicts the capacity of a slice after appending length
+// elements to an empty slice one at a time, following the rules described in
+// TestCapacityAllocation.  Whenever an append exceeds the capacity:
+//
+//   - below 256 the capacity doubles.
+//   - from 256 the capacity grows by (capacity + 3*256) / 4, a smooth transition
+//     from 2x growth for small slices towards 1.25x growth for large ones.
+//
+// The runtime then rounds the requested memory up to the nearest allocator size
+// class, which can leave a little extra capacity.  For 8 byte elements such as
+// int this rounding has no effect up to 512, beyond that each growth starts
+// from the rounded capacity and the real sequence drifts from this prediction.
+func MinCapacityFor(length int) int {
+	capacity := 0
+	for l := 1; l <= length; l++ {
+		if l > capacity {
+			capacity = nextCapacity(capacity, l)
+		}
+	}
+	return capacity
+}
+
+// nextCapacity mirrors the growth calculation in the runtimes growslice.
+func nextCapacity(oldCap, needed int) int {
+	doubled := oldCap * 2
+	if needed > doubled {
+		return needed
+	}
+	if oldCap < growthThreshold {
+		return doubled
+	}
+	newCap := oldCap
+	for newCap < needed {
+		newCap += (newCap + 3*growthThreshold) / 4
+	}
+	return newCap
+}
@@ -0,0 +1,39 @@
+package composite_types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// grown lives at package level so appends to it always happen on the heap.
+// A local slice can start out in a small stack buffer instead, which the
+// compiler is free to size differently from the rules being tested.
+var grown []int
+
+// appendOneByOne grows a slice an element at a time, returning its final cap.
+func appendOneByOne(length int) int {
+	grown = []int{}
+	for i := 0; i < length; i++ {
+		grown = append(grown, i)
+	}
+	return cap(grown)
+}
+
+func TestMinCapacityFor(t *testing.T) {
+	assert.Equal(t, MinCapacityFor(0), 0)
+	assert.Equal(t, MinCapacityFor(1), 1)
+	assert.Equal(t, MinCapacityFor(3), 4)
+	assert.Equal(t, MinCapacityFor(256), 256)
+	// Crossing 256 grows by (256 + 768) / 4, which happens to also double it.
+	assert.Equal(t, MinCapacityFor(257), 512)
+	assert.Equal(t, MinCapacityFor(513), 832)
+}
+
+func TestMinCapacityForMatchesAppend(t *testing.T) {
+	// Past 512 allocator size classes round the capacity up, so the
+	// prediction only holds exactly up to there.
+	for _, length := range []int{1, 2, 3, 5, 17, 100, 255, 256, 257, 300, 511, 512} {
+		assert.Equal(t, appendOneByOne(length), MinCapacityFor(length), "length %d", length)
+	}
+}
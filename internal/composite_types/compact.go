@@ -0,0 +1,23 @@
+package composite_types
+
+// CompactInPlace removes runs of consecutive equal elements from s, keeping
+// the first of each run, and returns the shortened slice.  This is the same
+// job as slices.Compact, written out to show the technique: a write index
+// trails the read index, and each element differing from the last kept one is
+// copied down to the write index.  No new slice is allocated, the result shares
+// the backing array of s.  The slots between the new and old length are zeroed
+// so they no longer reference (and keep alive) the removed values.
+func CompactInPlace[T comparable](s []T) []T {
+	if len(s) < 2 {
+		return s
+	}
+	write := 1
+	for read := 1; read < len(s); read++ {
+		if s[read] != s[write-1] {
+			s[write] = s[read]
+			write++
+		}
+	}
+	clear(s[write:])
+	return s[:write]
+}
@@ -0,0 +1,23 @@
+package composite_types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompactInPlace(t *testing.T) {
+	s := []int{1, 1, 2, 3, 3, 3, 1}
+	compacted := CompactInPlace(s)
+	assert.Equal(t, compacted, []int{1, 2, 3, 1})
+	// Shares the backing array, the freed tail has been zeroed.
+	assert.Same(t, &compacted[0], &s[0])
+	assert.Equal(t, s[len(compacted):], []int{0, 0, 0})
+}
+
+func TestCompactInPlaceNoDuplicates(t *testing.T) {
+	assert.Equal(t, CompactInPlace([]string{"a", "b", "a"}), []string{"a", "b", "a"})
+	assert.Equal(t, CompactInPlace([]string{"x", "x", "x"}), []string{"x"})
+	assert.Empty(t, CompactInPlace([]int{}))
+	assert.Nil(t, CompactInPlace[int](nil))
+}
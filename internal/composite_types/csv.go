@@ -0,0 +1,50 @@
+package composite_types
+
+import (
+	"encoding/csv"
+	"io"
+	"strings"
+)
+
+// ParseRows reads delimited rows from r, splitting each record on sep.  Quoted
+// fields (which may contain sep, quotes or newlines) are handled by the stdlib
+// csv reader.  Rows are not required to have the same number of fields.
+func ParseRows(r io.Reader, sep rune) ([][]string, error) {
+	reader := csv.NewReader(r)
+	reader.Comma = sep
+	reader.FieldsPerRecord = -1
+	return reader.ReadAll()
+}
+
+// WriteRows writes rows to w, separating fields with sep and terminating each
+// row with a newline.  Any field containing the separator, a double quote or
+// a newline is wrapped in double quotes, with embedded quotes doubled up so
+// that `say "hi"` becomes `"say ""hi"""`.  The output can be read back with
+// ParseRows.
+func WriteRows(w io.Writer, rows [][]string, sep rune) error {
+	var line strings.Builder
+	for _, row := range rows {
+		line.Reset()
+		for i, field := range row {
+			if i > 0 {
+				line.WriteRune(sep)
+			}
+			if needsQuoting(field, sep) {
+				line.WriteByte('"')
+				line.WriteString(strings.ReplaceAll(field, `"`, `""`))
+				line.WriteByte('"')
+				continue
+			}
+			line.WriteString(field)
+		}
+		line.WriteByte('\n')
+		if _, err := io.WriteString(w, line.String()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func needsQuoting(field string, sep rune) bool {
+	return strings.ContainsRune(field, sep) || strings.ContainsAny(field, "\"\r\n")
+}
@@ -0,0 +1,57 @@
+package composite_types
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteRowsPlain(t *testing.T) {
+	var buffer bytes.Buffer
+	err := WriteRows(&buffer, [][]string{{"a", "b"}, {"c", "d"}}, ',')
+	assert.NoError(t, err)
+	assert.Equal(t, buffer.String(), "a,b\nc,d\n")
+}
+
+func TestWriteRowsQuoting(t *testing.T) {
+	var buffer bytes.Buffer
+	err := WriteRows(&buffer, [][]string{{"hello, world", `say "hi"`, "two\nlines", "plain"}}, ',')
+	assert.NoError(t, err)
+	assert.Equal(t, buffer.String(), "\"hello, world\",\"say \"\"hi\"\"\",\"two\nlines\",plain\n")
+}
+
+func TestWriteRowsRoundTrip(t *testing.T) {
+	rows := [][]string{
+		{"name", "quote", "notes"},
+		{"alice", `she said "hi"`, "likes; semicolons"},
+		{"bob", "no quotes", "multi\nline"},
+		{"carol", "", "trailing sep;"},
+	}
+	for _, sep := range []rune{',', ';', '\t'} {
+		var buffer bytes.Buffer
+		assert.NoError(t, WriteRows(&buffer, rows, sep))
+		parsed, err := ParseRows(&buffer, sep)
+		assert.NoError(t, err)
+		assert.Equal(t, parsed, rows)
+	}
+}
+
+func TestParseRows(t *testing.T) {
+	parsed, err := ParseRows(strings.NewReader("a;b\n\"c;d\";e\n"), ';')
+	assert.NoError(t, err)
+	assert.Equal(t, parsed, [][]string{{"a", "b"}, {"c;d", "e"}})
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write(p []byte) (int, error) {
+	return 0, errors.New("boom")
+}
+
+func TestWriteRowsPropagatesErrors(t *testing.T) {
+	err := WriteRows(failingWriter{}, [][]string{{"a"}}, ',')
+	assert.EqualError(t, err, "boom")
+}
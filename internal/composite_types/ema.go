@@ -0,0 +1,40 @@
+package composite_types
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidAlpha is returned when an EMA smoothing factor is outside (0, 1].
+var ErrInvalidAlpha = errors.New("alpha must be in the range (0, 1]")
+
+// EMA is an exponential moving average.  Each new value moves the average
+// alpha of the way towards itself, so recent values carry more weight and
+// older values decay away exponentially.  Unlike a simple moving average
+// no window of previous values needs to be kept, just the current average.
+type EMA struct {
+	alpha   float64
+	average float64
+	seeded  bool
+}
+
+// New returns an EMA with the smoothing factor alpha.  An alpha of 1 tracks
+// the latest value exactly, values closer to 0 smooth more heavily.
+func New(alpha float64) (*EMA, error) {
+	if alpha <= 0 || alpha > 1 {
+		return nil, fmt.Errorf("%w: got %v", ErrInvalidAlpha, alpha)
+	}
+	return &EMA{alpha: alpha}, nil
+}
+
+// Add folds x into the average and returns the updated average.  The first
+// value seeds the average directly.
+func (e *EMA) Add(x float64) float64 {
+	if !e.seeded {
+		e.average = x
+		e.seeded = true
+		return e.average
+	}
+	e.average += e.alpha * (x - e.average)
+	return e.average
+}
@@ -0,0 +1,41 @@
+package composite_types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEMAConvergesOnConstant(t *testing.T) {
+	e, err := New(0.2)
+	assert.NoError(t, err)
+	e.Add(0)
+	var average float64
+	for i := 0; i < 100; i++ {
+		average = e.Add(50)
+	}
+	assert.InDelta(t, average, 50.0, 1e-6)
+}
+
+func TestEMAAlphaOneTracksLatest(t *testing.T) {
+	e, err := New(1)
+	assert.NoError(t, err)
+	for _, v := range []float64{3, -7, 12.5, 0} {
+		assert.Equal(t, e.Add(v), v)
+	}
+}
+
+func TestEMAWeighting(t *testing.T) {
+	e, err := New(0.5)
+	assert.NoError(t, err)
+	assert.Equal(t, e.Add(10), 10.0)
+	assert.Equal(t, e.Add(20), 15.0)
+	assert.Equal(t, e.Add(20), 17.5)
+}
+
+func TestEMAInvalidAlpha(t *testing.T) {
+	for _, alpha := range []float64{0, -0.5, 1.01} {
+		_, err := New(alpha)
+		assert.ErrorIs(t, err, ErrInvalidAlpha)
+	}
+}
@@ -0,0 +1,96 @@
+package composite_types
+
+// gapBufferInitialGap is the size of the gap in a new (or freshly grown) buffer.
+const gapBufferInitialGap = 16
+
+// GapBuffer is the data structure behind many text editors.  The text lives
+// in a single rune slice with a "gap" of unused space at the cursor position.
+// Inserting at the cursor just fills the gap, and deleting just widens it, so
+// neither has to shift the rest of the text along.  Moving the cursor moves one
+// rune across the gap.  Only when the gap is used up does the slice grow.
+//
+//	text: "hello world", cursor after "hello"
+//	[h e l l o _ _ _ _   w o r l d]
+//	           ^gapStart ^gapEnd
+type GapBuffer struct {
+	buf      []rune
+	gapStart int
+	gapEnd   int
+}
+
+// NewGapBuffer returns a GapBuffer holding s, with the cursor at the end.
+func NewGapBuffer(s string) *GapBuffer {
+	runes := []rune(s)
+	buf := make([]rune, len(runes)+gapBufferInitialGap)
+	copy(buf, runes)
+	return &GapBuffer{buf: buf, gapStart: len(runes), gapEnd: len(buf)}
+}
+
+// Insert writes r at the cursor, leaving the cursor after it.
+func (g *GapBuffer) Insert(r rune) {
+	if g.gapStart == g.gapEnd {
+		g.grow()
+	}
+	g.buf[g.gapStart] = r
+	g.gapStart++
+}
+
+// Delete removes the rune before the cursor, like backspace.  It reports
+// false if the cursor is already at the start.
+func (g *GapBuffer) Delete() bool {
+	if g.gapStart == 0 {
+		return false
+	}
+	g.gapStart--
+	return true
+}
+
+// MoveLeft moves the cursor one rune left, reporting false at the start.
+func (g *GapBuffer) MoveLeft() bool {
+	if g.gapStart == 0 {
+		return false
+	}
+	g.gapStart--
+	g.gapEnd--
+	g.buf[g.gapEnd] = g.buf[g.gapStart]
+	return true
+}
+
+// MoveRight moves the cursor one rune right, reporting false at the end.
+func (g *GapBuffer) MoveRight() bool {
+	if g.gapEnd == len(g.buf) {
+		return false
+	}
+	g.buf[g.gapStart] = g.buf[g.gapEnd]
+	g.gapStart++
+	g.gapEnd++
+	return true
+}
+
+// Cursor returns the cursor position, in runes from the start.
+func (g *GapBuffer) Cursor() int {
+	return g.gapStart
+}
+
+// Len returns the number of runes of text held.
+func (g *GapBuffer) Len() int {
+	return len(g.buf) - (g.gapEnd - g.gapStart)
+}
+
+// String returns the text, skipping over the gap.
+func (g *GapBuffer) String() string {
+	text := make([]rune, 0, g.Len())
+	text = append(text, g.buf[:g.gapStart]...)
+	text = append(text, g.buf[g.gapEnd:]...)
+	return string(text)
+}
+
+// grow doubles the buffer, moving the text after the gap to the new end.
+func (g *GapBuffer) grow() {
+	buf := make([]rune, len(g.buf)*2+gapBufferInitialGap)
+	copy(buf, g.buf[:g.gapStart])
+	tail := len(g.buf) - g.gapEnd
+	copy(buf[len(buf)-tail:], g.buf[g.gapEnd:])
+	g.gapEnd = len(buf) - tail
+	g.buf = buf
+}
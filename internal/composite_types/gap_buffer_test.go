@@ -0,0 +1,74 @@
+package composite_types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func insertString(g *GapBuffer, s string) {
+	for _, r := range s {
+		g.Insert(r)
+	}
+}
+
+func TestGapBufferInsertAtEnd(t *testing.T) {
+	g := NewGapBuffer("")
+	insertString(g, "hello")
+	assert.Equal(t, g.String(), "hello")
+	assert.Equal(t, g.Cursor(), 5)
+}
+
+func TestGapBufferInsertInMiddle(t *testing.T) {
+	g := NewGapBuffer("helo world")
+	for i := 0; i < 7; i++ {
+		g.MoveLeft()
+	}
+	g.Insert('l')
+	assert.Equal(t, g.String(), "hello world")
+
+	// Jump to the very start and prepend.
+	for g.MoveLeft() {
+	}
+	insertString(g, ">> ")
+	assert.Equal(t, g.String(), ">> hello world")
+	assert.Equal(t, g.Cursor(), 3)
+}
+
+func TestGapBufferDelete(t *testing.T) {
+	g := NewGapBuffer("hello world")
+	for i := 0; i < 6; i++ {
+		g.MoveLeft()
+	}
+	// Backspace "hello" away from in front of " world".
+	for i := 0; i < 5; i++ {
+		assert.True(t, g.Delete())
+	}
+	assert.False(t, g.Delete())
+	assert.Equal(t, g.String(), " world")
+
+	g.MoveRight()
+	insertString(g, "new ")
+	assert.Equal(t, g.String(), " new world")
+}
+
+func TestGapBufferMoveBounds(t *testing.T) {
+	g := NewGapBuffer("ab")
+	assert.False(t, g.MoveRight())
+	assert.True(t, g.MoveLeft())
+	assert.True(t, g.MoveLeft())
+	assert.False(t, g.MoveLeft())
+	assert.Equal(t, g.String(), "ab")
+}
+
+func TestGapBufferGrowsWithMultiByteRunes(t *testing.T) {
+	g := NewGapBuffer("end")
+	g.MoveLeft()
+	g.MoveLeft()
+	g.MoveLeft()
+	for i := 0; i < 100; i++ {
+		g.Insert('ॡ')
+	}
+	assert.Equal(t, g.Len(), 103)
+	assert.Equal(t, []rune(g.String())[99:], []rune("ॡend"))
+}
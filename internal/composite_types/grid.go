@@ -0,0 +1,40 @@
+package composite_types
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// Grid2D formats a two dimensional slice as rows of space separated cells,
+// right aligning every cell to the width of the widest cell in its column.
+// Widths are counted in runes rather than bytes, so multi-byte cells line up.
+// Inner slices may be ragged, a short row simply ends early.  Each row is
+// terminated with a newline.
+func Grid2D[T any](g [][]T) string {
+	cells := make([][]string, len(g))
+	var widths []int
+	for i, row := range g {
+		cells[i] = make([]string, len(row))
+		for j, value := range row {
+			cell := fmt.Sprint(value)
+			cells[i][j] = cell
+			if j == len(widths) {
+				widths = append(widths, 0)
+			}
+			widths[j] = max(widths[j], utf8.RuneCountInString(cell))
+		}
+	}
+	var b strings.Builder
+	for _, row := range cells {
+		for j, cell := range row {
+			if j > 0 {
+				b.WriteByte(' ')
+			}
+			b.WriteString(strings.Repeat(" ", widths[j]-utf8.RuneCountInString(cell)))
+			b.WriteString(cell)
+		}
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
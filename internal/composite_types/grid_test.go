@@ -0,0 +1,43 @@
+package composite_types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGrid2D(t *testing.T) {
+	grid := [][]int{
+		{1, 200, 3},
+		{40, 5, 6},
+		{7, 8, 9000},
+	}
+	assert.Equal(t, Grid2D(grid), ""+
+		" 1 200    3\n"+
+		"40   5    6\n"+
+		" 7   8 9000\n")
+}
+
+func TestGrid2DFromArray(t *testing.T) {
+	// gameBoard is an array of arrays, slice each row to format it.
+	rows := make([][]int, len(gameBoard))
+	for i := range gameBoard {
+		rows[i] = gameBoard[i][:]
+	}
+	assert.Equal(t, Grid2D(rows), "0 0 0\n0 0 0\n0 0 0\n")
+}
+
+func TestGrid2DRagged(t *testing.T) {
+	grid := [][]string{
+		{"a", "bb"},
+		{"ccc"},
+		{},
+		{"d", "e", "ü"},
+	}
+	assert.Equal(t, Grid2D(grid), ""+
+		"  a bb\n"+
+		"ccc\n"+
+		"\n"+
+		"  d  e ü\n")
+	assert.Equal(t, Grid2D[int](nil), "")
+}
@@ -0,0 +1,80 @@
+package composite_types
+
+import (
+	"encoding/binary"
+	"hash"
+	"hash/fnv"
+	"math"
+	"reflect"
+)
+
+// HashKey returns a deterministic 64 bit FNV-1a hash of any comparable key.
+// reflect is used to walk the key and feed its contents into the hash, so
+// custom hash based structures can accept arbitrary comparable keys without
+// each key type implementing its own hashing.  Equal keys always hash equally.
+// Different keys can collide, handling collisions is the callers job.
+// Note: pointers and channels hash their address, not what they point at,
+// which matches how == compares them.
+func HashKey[K comparable](k K) uint64 {
+	h := fnv.New64a()
+	// Hash through a pointer so interface typed keys (K = any) are walked
+	// as an interface, including their dynamic type.
+	hashValue(h, reflect.ValueOf(&k).Elem())
+	return h.Sum64()
+}
+
+func hashValue(h hash.Hash64, v reflect.Value) {
+	var buf [8]byte
+	writeUint := func(u uint64) {
+		binary.LittleEndian.PutUint64(buf[:], u)
+		h.Write(buf[:])
+	}
+	writeFloat := func(f float64) {
+		// +0 and -0 compare equal, so must hash equally.
+		if f == 0 {
+			f = 0
+		}
+		writeUint(math.Float64bits(f))
+	}
+
+	switch v.Kind() {
+	case reflect.Bool:
+		if v.Bool() {
+			writeUint(1)
+		} else {
+			writeUint(0)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		writeUint(uint64(v.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		writeUint(v.Uint())
+	case reflect.Float32, reflect.Float64:
+		writeFloat(v.Float())
+	case reflect.Complex64, reflect.Complex128:
+		c := v.Complex()
+		writeFloat(real(c))
+		writeFloat(imag(c))
+	case reflect.String:
+		// Prefix the length, so ("ab", "c") and ("a", "bc") differ.
+		writeUint(uint64(v.Len()))
+		h.Write([]byte(v.String()))
+	case reflect.Pointer, reflect.Chan, reflect.UnsafePointer:
+		writeUint(uint64(v.Pointer()))
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			hashValue(h, v.Index(i))
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			hashValue(h, v.Field(i))
+		}
+	case reflect.Interface:
+		if v.IsNil() {
+			writeUint(0)
+			return
+		}
+		elem := v.Elem()
+		h.Write([]byte(elem.Type().String()))
+		hashValue(h, elem)
+	}
+}
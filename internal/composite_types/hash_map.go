@@ -0,0 +1,136 @@
+package composite_types
+
+type slotState uint8
+
+const (
+	slotEmpty slotState = iota
+	slotOccupied
+	slotDeleted
+)
+
+// hashMapInitialSize must be a power of two, so a hash can be mapped to a slot
+// with a cheap bit mask rather than a modulo.
+const hashMapInitialSize = 8
+
+type hashSlot[K comparable, V any] struct {
+	key   K
+	value V
+	state slotState
+}
+
+// HashMap is a hand rolled hash table, purely to contrast with the builtin map.
+// It uses open addressing: every entry lives directly in the slots slice, and a
+// key whose slot is taken probes forward (linear probing) to the next free one.
+// Deleting can't simply empty a slot, as that would break the probe chain for
+// keys stored after it, so deleted slots are marked with a tombstone instead.
+// The table doubles in size when occupied slots plus tombstones pass 75%.
+type HashMap[K comparable, V any] struct {
+	slots      []hashSlot[K, V]
+	count      int
+	tombstones int
+	hash       func(K) uint64
+}
+
+// NewHashMap returns an empty HashMap which hashes keys with HashKey.
+func NewHashMap[K comparable, V any]() *HashMap[K, V] {
+	return newHashMapWithHash[K, V](HashKey[K])
+}
+
+func newHashMapWithHash[K comparable, V any](hash func(K) uint64) *HashMap[K, V] {
+	return &HashMap[K, V]{slots: make([]hashSlot[K, V], hashMapInitialSize), hash: hash}
+}
+
+// Set stores value under key, replacing any existing value.
+func (m *HashMap[K, V]) Set(key K, value V) {
+	if (m.count+m.tombstones+1)*4 > len(m.slots)*3 {
+		m.resize()
+	}
+	mask := len(m.slots) - 1
+	tombstone := -1
+	for i := int(m.hash(key)) & mask; ; i = (i + 1) & mask {
+		slot := &m.slots[i]
+		switch slot.state {
+		case slotOccupied:
+			if slot.key == key {
+				slot.value = value
+				return
+			}
+		case slotDeleted:
+			// Remember the first tombstone for reuse, but keep probing as the
+			// key may already exist further along the chain.
+			if tombstone < 0 {
+				tombstone = i
+			}
+		case slotEmpty:
+			if tombstone >= 0 {
+				slot = &m.slots[tombstone]
+				m.tombstones--
+			}
+			*slot = hashSlot[K, V]{key: key, value: value, state: slotOccupied}
+			m.count++
+			return
+		}
+	}
+}
+
+// Get returns the value stored under key and whether it was present.
+func (m *HashMap[K, V]) Get(key K) (V, bool) {
+	if i := m.find(key); i >= 0 {
+		return m.slots[i].value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Delete removes key, reporting whether it was present.
+func (m *HashMap[K, V]) Delete(key K) bool {
+	i := m.find(key)
+	if i < 0 {
+		return false
+	}
+	// Zero the slot so the key and value can be garbage collected.
+	m.slots[i] = hashSlot[K, V]{state: slotDeleted}
+	m.count--
+	m.tombstones++
+	return true
+}
+
+// Len returns the number of keys stored.
+func (m *HashMap[K, V]) Len() int {
+	return m.count
+}
+
+// find returns the slot index holding key, or -1.  An empty slot ends the
+// probe chain, tombstones are stepped over.
+func (m *HashMap[K, V]) find(key K) int {
+	mask := len(m.slots) - 1
+	for i, probes := int(m.hash(key))&mask, 0; probes < len(m.slots); i, probes = (i+1)&mask, probes+1 {
+		switch slot := &m.slots[i]; slot.state {
+		case slotEmpty:
+			return -1
+		case slotOccupied:
+			if slot.key == key {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// resize rehashes every live entry into a new table, dropping all tombstones.
+// The table only doubles if the live entries need the room, a table full of
+// tombstones is rebuilt at the same size.
+func (m *HashMap[K, V]) resize() {
+	size := len(m.slots)
+	if (m.count+1)*2 > size {
+		size *= 2
+	}
+	old := m.slots
+	m.slots = make([]hashSlot[K, V], size)
+	m.count, m.tombstones = 0, 0
+	for _, slot := range old {
+		if slot.state == slotOccupied {
+			m.Set(slot.key, slot.value)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package composite_types
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHashMapSetGet(t *testing.T) {
+	m := NewHashMap[string, int]()
+	m.Set("one", 1)
+	m.Set("two", 2)
+	m.Set("one", 100)
+
+	v, ok := m.Get("one")
+	assert.True(t, ok)
+	assert.Equal(t, v, 100)
+	v, ok = m.Get("two")
+	assert.True(t, ok)
+	assert.Equal(t, v, 2)
+	_, ok = m.Get("three")
+	assert.False(t, ok)
+	assert.Equal(t, m.Len(), 2)
+}
+
+func TestHashMapCollisions(t *testing.T) {
+	// Every key hashes to the same slot, so every insert after the first
+	// has to probe along the chain.
+	m := newHashMapWithHash[int, string](func(int) uint64 { return 3 })
+	for i := 0; i < 5; i++ {
+		m.Set(i, fmt.Sprint(i))
+	}
+	for i := 0; i < 5; i++ {
+		v, ok := m.Get(i)
+		assert.True(t, ok)
+		assert.Equal(t, v, fmt.Sprint(i))
+	}
+	_, ok := m.Get(5)
+	assert.False(t, ok)
+}
+
+func TestHashMapResize(t *testing.T) {
+	m := NewHashMap[int, int]()
+	assert.Len(t, m.slots, hashMapInitialSize)
+	for i := 0; i < 100; i++ {
+		m.Set(i, i*i)
+	}
+	assert.Equal(t, m.Len(), 100)
+	assert.Greater(t, len(m.slots), 100)
+	for i := 0; i < 100; i++ {
+		v, ok := m.Get(i)
+		assert.True(t, ok)
+		assert.Equal(t, v, i*i)
+	}
+}
+
+func TestHashMapDeleteLeavesTombstones(t *testing.T) {
+	m := newHashMapWithHash[int, int](func(int) uint64 { return 0 })
+	m.Set(1, 1)
+	m.Set(2, 2)
+	m.Set(3, 3)
+
+	// Deleting the middle of the probe chain must not hide the key after it.
+	assert.True(t, m.Delete(2))
+	assert.False(t, m.Delete(2))
+	assert.Equal(t, m.slots[1].state, slotDeleted)
+	v, ok := m.Get(3)
+	assert.True(t, ok)
+	assert.Equal(t, v, 3)
+	_, ok = m.Get(2)
+	assert.False(t, ok)
+	assert.Equal(t, m.Len(), 2)
+
+	// Re-inserting reuses the tombstone, and does not duplicate existing keys.
+	m.Set(4, 4)
+	assert.Equal(t, m.slots[1].key, 4)
+	m.Set(3, 30)
+	v, _ = m.Get(3)
+	assert.Equal(t, v, 30)
+	assert.Equal(t, m.Len(), 3)
+}
+
+func TestHashMapChurnDoesNotGrowForever(t *testing.T) {
+	// Constant insert/delete leaves tombstones, which are cleared by a same
+	// size rehash rather than growing the table.
+	m := NewHashMap[int, int]()
+	for i := 0; i < 1000; i++ {
+		m.Set(i, i)
+		m.Delete(i)
+	}
+	assert.Zero(t, m.Len())
+	assert.Len(t, m.slots, hashMapInitialSize)
+}
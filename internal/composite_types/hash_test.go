@@ -0,0 +1,57 @@
+package composite_types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type hashPoint struct {
+	X, Y  int
+	Label string
+}
+
+func TestHashKeyEqualValuesHashEqually(t *testing.T) {
+	assert.Equal(t, HashKey(42), HashKey(42))
+	assert.Equal(t, HashKey("foo"), HashKey("f"+"oo"))
+	assert.Equal(t, HashKey(hashPoint{1, 2, "a"}), HashKey(hashPoint{1, 2, "a"}))
+	assert.Equal(t, HashKey([2]string{"a", "b"}), HashKey([2]string{"a", "b"}))
+	// +0 == -0, so they must hash the same.
+	negativeZero := 0.0
+	negativeZero = -negativeZero
+	assert.Equal(t, HashKey(0.0), HashKey(negativeZero))
+}
+
+func TestHashKeyDifferentValues(t *testing.T) {
+	// Collisions are possible in general, but not for these simple cases.
+	assert.NotEqual(t, HashKey(1), HashKey(2))
+	assert.NotEqual(t, HashKey("foo"), HashKey("bar"))
+	assert.NotEqual(t, HashKey(hashPoint{1, 2, "a"}), HashKey(hashPoint{2, 1, "a"}))
+	assert.NotEqual(t, HashKey([2]string{"ab", "c"}), HashKey([2]string{"a", "bc"}))
+}
+
+func TestHashKeyVariousTypes(t *testing.T) {
+	x := 1
+	assert.NotPanics(t, func() {
+		HashKey(int8(-1))
+		HashKey(uint64(1 << 63))
+		HashKey(true)
+		HashKey(3.14)
+		HashKey(complex(1, 2))
+		HashKey(&x)
+		HashKey(struct{}{})
+		HashKey(hashPoint{})
+	})
+	// Pointers hash by address, matching ==.
+	y := 1
+	assert.Equal(t, HashKey(&x), HashKey(&x))
+	assert.NotEqual(t, HashKey(&x), HashKey(&y))
+}
+
+func TestHashKeyInterfaces(t *testing.T) {
+	var nilKey any
+	assert.Equal(t, HashKey(nilKey), HashKey[any](nil))
+	assert.Equal(t, HashKey[any](1), HashKey[any](1))
+	// The dynamic type is part of the hash, int(1) != int64(1).
+	assert.NotEqual(t, HashKey[any](1), HashKey[any](int64(1)))
+}
@@ -0,0 +1,27 @@
+package composite_types
+
+import "strings"
+
+// JoinRunes concatenates parts with sep between each, producing the same output
+// as strings.Join.  Strings are immutable, so building one with += copies
+// everything built so far into a brand new string on every step.  Instead the
+// final length is summed up front and a strings.Builder is grown once to that
+// size, leaving a single allocation no matter how many parts there are.
+func JoinRunes(parts []string, sep string) string {
+	if len(parts) == 0 {
+		return ""
+	}
+	size := len(sep) * (len(parts) - 1)
+	for _, part := range parts {
+		size += len(part)
+	}
+	var b strings.Builder
+	b.Grow(size)
+	for i, part := range parts {
+		if i > 0 {
+			b.WriteString(sep)
+		}
+		b.WriteString(part)
+	}
+	return b.String()
+}
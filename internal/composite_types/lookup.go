@@ -0,0 +1,13 @@
+package composite_types
+
+// SliceLookup reports whether target is in s by checking every element in
+// turn, an O(n) scan.  For small slices this is often faster than hashing
+// into a map, see the lookup benchmarks for where the crossover lies.
+func SliceLookup[T comparable](s []T, target T) bool {
+	for _, v := range s {
+		if v == target {
+			return true
+		}
+	}
+	return false
+}
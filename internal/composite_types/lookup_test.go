@@ -0,0 +1,61 @@
+package composite_types
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSliceAndSetLookupAgree(t *testing.T) {
+	values := []int{5, 3, 9, 1, 7}
+	set := NewSet(values...)
+	for target := 0; target < 10; target++ {
+		assert.Equal(t, SliceLookup(values, target), set.Contains(target), "target %d", target)
+	}
+	assert.False(t, SliceLookup(nil, 1))
+}
+
+/*
+The benchmarks below look up a missing value (the worst case for the slice,
+every element must be checked) in collections of increasing size.  The slice
+scan grows linearly with the size, while the set stays roughly constant.  For
+a handful of ints the scan wins as it avoids hashing entirely, typically the
+set pulls ahead somewhere between 8 and 32 elements.  Run them with:
+
+	go test -bench Lookup ./internal/composite_types/
+*/
+
+var lookupSizes = []int{1, 4, 8, 16, 32, 64, 256, 1024}
+
+var lookupSink bool
+
+func lookupValues(n int) []int {
+	values := make([]int, n)
+	for i := range values {
+		values[i] = i
+	}
+	return values
+}
+
+func BenchmarkSliceLookup(b *testing.B) {
+	for _, size := range lookupSizes {
+		values := lookupValues(size)
+		b.Run(fmt.Sprintf("size=%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				lookupSink = SliceLookup(values, -1)
+			}
+		})
+	}
+}
+
+func BenchmarkSetLookup(b *testing.B) {
+	for _, size := range lookupSizes {
+		set := NewSet(lookupValues(size)...)
+		b.Run(fmt.Sprintf("size=%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				lookupSink = set.Contains(-1)
+			}
+		})
+	}
+}
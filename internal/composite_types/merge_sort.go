@@ -0,0 +1,83 @@
+package composite_types
+
+import (
+	"context"
+	"slices"
+
+	"github.com/symonk/learning-go-book/internal/constraints"
+)
+
+// sequentialSortThreshold is the size below which splitting any further is
+// not worth the overhead, and the chunk is sorted directly.
+const sequentialSortThreshold = 1024
+
+// ParallelMergeSort sorts s in place.  The slice is recursively halved, with
+// each half sorted on its own goroutine while the worker budget allows, and the
+// sorted halves merged back together.  ctx is checked before every split and
+// merge, if it is cancelled the sort stops early and returns ctx.Err(), leaving
+// s holding its original elements in an unspecified order.
+func ParallelMergeSort[T constraints.Ordered](ctx context.Context, s []T, workers int) error {
+	if workers < 1 {
+		workers = 1
+	}
+	buffer := make([]T, len(s))
+	return parallelMergeSort(ctx, s, buffer, workers)
+}
+
+func parallelMergeSort[T constraints.Ordered](ctx context.Context, s, buffer []T, workers int) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if len(s) <= sequentialSortThreshold {
+		slices.Sort(s)
+		return nil
+	}
+
+	mid := len(s) / 2
+	if workers > 1 {
+		// Hand half of the budget to the left half, on a new goroutine.
+		leftWorkers := workers / 2
+		done := make(chan error, 1)
+		go func() {
+			done <- parallelMergeSort(ctx, s[:mid], buffer[:mid], leftWorkers)
+		}()
+		rightErr := parallelMergeSort(ctx, s[mid:], buffer[mid:], workers-leftWorkers)
+		if leftErr := <-done; leftErr != nil {
+			return leftErr
+		}
+		if rightErr != nil {
+			return rightErr
+		}
+	} else {
+		if err := parallelMergeSort(ctx, s[:mid], buffer[:mid], 1); err != nil {
+			return err
+		}
+		if err := parallelMergeSort(ctx, s[mid:], buffer[mid:], 1); err != nil {
+			return err
+		}
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	merge(s[:mid], s[mid:], buffer)
+	copy(s, buffer)
+	return nil
+}
+
+// merge writes the sorted union of the sorted slices a and b into out.
+func merge[T constraints.Ordered](a, b, out []T) {
+	i, j, k := 0, 0, 0
+	for i < len(a) && j < len(b) {
+		if b[j] < a[i] {
+			out[k] = b[j]
+			j++
+		} else {
+			out[k] = a[i]
+			i++
+		}
+		k++
+	}
+	k += copy(out[k:], a[i:])
+	copy(out[k:], b[j:])
+}
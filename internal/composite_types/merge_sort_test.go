@@ -0,0 +1,50 @@
+package composite_types
+
+import (
+	"context"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func randomInts(n int, seed int64) []int {
+	rng := rand.New(rand.NewSource(seed))
+	s := make([]int, n)
+	for i := range s {
+		s[i] = rng.Intn(n)
+	}
+	return s
+}
+
+func TestParallelMergeSortMatchesSequentialSort(t *testing.T) {
+	for _, workers := range []int{0, 1, 3, 8} {
+		for _, n := range []int{0, 1, 10, 1025, 50_000} {
+			s := randomInts(n, int64(n))
+			expected := slices.Clone(s)
+			slices.Sort(expected)
+
+			assert.NoError(t, ParallelMergeSort(context.Background(), s, workers))
+			assert.Equal(t, s, expected)
+		}
+	}
+}
+
+func TestParallelMergeSortStrings(t *testing.T) {
+	s := []string{"pear", "apple", "fig", "banana"}
+	assert.NoError(t, ParallelMergeSort(context.Background(), s, 4))
+	assert.Equal(t, s, []string{"apple", "banana", "fig", "pear"})
+}
+
+func TestParallelMergeSortCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	s := randomInts(100_000, 1)
+	original := slices.Clone(s)
+
+	err := ParallelMergeSort(ctx, s, 4)
+	assert.ErrorIs(t, err, context.Canceled)
+	// Returned before doing any work.
+	assert.Equal(t, s, original)
+}
@@ -0,0 +1,116 @@
+package composite_types
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/symonk/learning-go-book/internal/constraints"
+)
+
+var (
+	// ErrInvalidCount is returned when asked to generate fewer than one value.
+	ErrInvalidCount = errors.New("count must be at least 1")
+	// ErrLengthMismatch is returned when two vectors are expected to be the same length.
+	ErrLengthMismatch = errors.New("vector lengths differ")
+	// ErrZeroVector is returned when a calculation is undefined for a zero length vector.
+	ErrZeroVector = errors.New("zero vector")
+)
+
+// IntRange returns the integers from start towards stop (exclusive) moving by
+// step each time, much like pythons range().  A negative step counts down.
+// The output is sized up front as we can calculate exactly how many elements
+// will be produced, saving any re-allocations while appending.
+// A step of zero would never reach stop and panics.
+//
+// The count is worked out on uint, stop - start (or a huge step) can exceed
+// the range of int, while the distance between any two ints always fits in
+// a uint.
+func IntRange(start, stop, step int) []int {
+	if step == 0 {
+		panic("IntRange: step must not be zero")
+	}
+	var count int
+	if step > 0 && start < stop {
+		count = int((uint(stop)-uint(start)-1)/uint(step) + 1)
+	} else if step < 0 && start > stop {
+		count = int((uint(start)-uint(stop)-1)/-uint(step) + 1)
+	}
+	out := make([]int, 0, count)
+	for i := 0; i < count; i++ {
+		out = append(out, start+i*step)
+	}
+	return out
+}
+
+// Linspace returns n evenly spaced values from start to stop, inclusive of both
+// ends.  A single value is just [start].  The final element is set to stop
+// explicitly, rather than accumulated, so floating point error cannot leave
+// it fractionally short.
+func Linspace(start, stop float64, n int) ([]float64, error) {
+	if n < 1 {
+		return nil, fmt.Errorf("%w: got %d", ErrInvalidCount, n)
+	}
+	if n == 1 {
+		return []float64{start}, nil
+	}
+	out := make([]float64, n)
+	step := (stop - start) / float64(n-1)
+	for i := range out {
+		out[i] = start + float64(i)*step
+	}
+	out[n-1] = stop
+	return out, nil
+}
+
+// CumProd returns the running product of s, where each index holds the product
+// of every element up to and including itself.  Once a zero is seen every
+// following product is also zero.
+func CumProd[T constraints.Integer | constraints.Float](s []T) []T {
+	out := make([]T, len(s))
+	var product T = 1
+	for i, v := range s {
+		product *= v
+		out[i] = product
+	}
+	return out
+}
+
+// Dot returns the dot product of a and b, the sum of their element wise
+// products.  Both vectors must be the same length.
+func Dot(a, b []float64) (float64, error) {
+	if len(a) != len(b) {
+		return 0, fmt.Errorf("%w: %d != %d", ErrLengthMismatch, len(a), len(b))
+	}
+	var sum float64
+	for i := range a {
+		sum += a[i] * b[i]
+	}
+	return sum, nil
+}
+
+// Norm returns the Euclidean (L2) length of a, the square root of the vector
+// dotted with itself.  An empty vector has a norm of zero.
+func Norm(a []float64) float64 {
+	var sum float64
+	for _, v := range a {
+		sum += v * v
+	}
+	return math.Sqrt(sum)
+}
+
+// CosineSimilarity returns the cosine of the angle between a and b, ranging
+// from 1 (same direction) through 0 (orthogonal) to -1 (opposite directions).
+// It is the dot product divided by the product of both norms, so is undefined
+// when either vector has a norm of zero.
+func CosineSimilarity(a, b []float64) (float64, error) {
+	dot, err := Dot(a, b)
+	if err != nil {
+		return 0, err
+	}
+	normA, normB := Norm(a), Norm(b)
+	if normA == 0 || normB == 0 {
+		return 0, ErrZeroVector
+	}
+	return dot / (normA * normB), nil
+}
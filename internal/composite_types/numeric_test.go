@@ -0,0 +1,139 @@
+package composite_types
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIntRangeAscending(t *testing.T) {
+	r := IntRange(0, 5, 1)
+	assert.Equal(t, r, []int{0, 1, 2, 3, 4})
+	assert.Equal(t, cap(r), len(r))
+}
+
+func TestIntRangeDescending(t *testing.T) {
+	assert.Equal(t, IntRange(5, 0, -1), []int{5, 4, 3, 2, 1})
+	assert.Equal(t, IntRange(10, 0, -3), []int{10, 7, 4, 1})
+}
+
+func TestIntRangeOvershootingStep(t *testing.T) {
+	// 0, 4, 8 - the next step (12) would pass stop.
+	assert.Equal(t, IntRange(0, 10, 4), []int{0, 4, 8})
+	// A step larger than the whole range yields just start.
+	assert.Equal(t, IntRange(0, 3, 100), []int{0})
+}
+
+func TestIntRangeHugeValues(t *testing.T) {
+	// Neither the step nor the span may overflow while counting.
+	assert.Equal(t, IntRange(0, 10, math.MaxInt), []int{0})
+	assert.Equal(t, IntRange(10, 0, math.MinInt), []int{10})
+	assert.Equal(t, IntRange(math.MinInt, math.MaxInt, math.MaxInt), []int{math.MinInt, -1, math.MaxInt - 1})
+	assert.Equal(t, IntRange(math.MaxInt, math.MinInt, -math.MaxInt), []int{math.MaxInt, 0, -math.MaxInt})
+}
+
+func TestIntRangeEmpty(t *testing.T) {
+	// Stepping away from stop produces nothing rather than looping forever.
+	assert.Empty(t, IntRange(0, 5, -1))
+	assert.Empty(t, IntRange(5, 5, 1))
+}
+
+func TestIntRangeZeroStepPanics(t *testing.T) {
+	assert.Panics(t, func() { IntRange(0, 5, 0) })
+}
+
+func TestLinspaceSingle(t *testing.T) {
+	s, err := Linspace(3, 10, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, s, []float64{3})
+}
+
+func TestLinspaceEndpoints(t *testing.T) {
+	s, err := Linspace(-1, 1, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, s, []float64{-1, 1})
+}
+
+func TestLinspaceEvenSpacing(t *testing.T) {
+	s, err := Linspace(0, 1, 11)
+	assert.NoError(t, err)
+	assert.Len(t, s, 11)
+	assert.Equal(t, s[0], 0.0)
+	assert.Equal(t, s[10], 1.0)
+	for i := 1; i < len(s); i++ {
+		assert.InDelta(t, s[i]-s[i-1], 0.1, 1e-9)
+	}
+}
+
+func TestLinspaceInvalidCount(t *testing.T) {
+	_, err := Linspace(0, 1, 0)
+	assert.ErrorIs(t, err, ErrInvalidCount)
+}
+
+func TestCumProd(t *testing.T) {
+	assert.Equal(t, CumProd([]int{1, 2, 3, 4}), []int{1, 2, 6, 24})
+	assert.Equal(t, CumProd([]float64{0.5, 4, 1.5}), []float64{0.5, 2, 3})
+}
+
+func TestCumProdZero(t *testing.T) {
+	assert.Equal(t, CumProd([]int{2, 3, 0, 5, 7}), []int{2, 6, 0, 0, 0})
+}
+
+func TestCumProdEmpty(t *testing.T) {
+	out := CumProd([]int{})
+	assert.NotNil(t, out)
+	assert.Empty(t, out)
+}
+
+func TestDot(t *testing.T) {
+	// 1*4 + 2*5 + 3*6
+	d, err := Dot([]float64{1, 2, 3}, []float64{4, 5, 6})
+	assert.NoError(t, err)
+	assert.Equal(t, d, 32.0)
+}
+
+func TestDotOrthogonal(t *testing.T) {
+	d, err := Dot([]float64{1, 0}, []float64{0, 1})
+	assert.NoError(t, err)
+	assert.Zero(t, d)
+}
+
+func TestDotLengthMismatch(t *testing.T) {
+	_, err := Dot([]float64{1, 2}, []float64{1})
+	assert.ErrorIs(t, err, ErrLengthMismatch)
+}
+
+func TestNorm(t *testing.T) {
+	// The classic 3, 4, 5 triangle.
+	assert.Equal(t, Norm([]float64{3, 4}), 5.0)
+	assert.InDelta(t, Norm([]float64{1, 1}), 1.41421356, 1e-8)
+	assert.Zero(t, Norm(nil))
+}
+
+func TestCosineSimilarity(t *testing.T) {
+	identical, err := CosineSimilarity([]float64{1, 2, 3}, []float64{1, 2, 3})
+	assert.NoError(t, err)
+	assert.InDelta(t, identical, 1.0, 1e-9)
+
+	// Scaling a vector does not change its direction.
+	scaled, err := CosineSimilarity([]float64{1, 2, 3}, []float64{2, 4, 6})
+	assert.NoError(t, err)
+	assert.InDelta(t, scaled, 1.0, 1e-9)
+
+	opposite, err := CosineSimilarity([]float64{1, 2, 3}, []float64{-1, -2, -3})
+	assert.NoError(t, err)
+	assert.InDelta(t, opposite, -1.0, 1e-9)
+
+	orthogonal, err := CosineSimilarity([]float64{1, 0}, []float64{0, 5})
+	assert.NoError(t, err)
+	assert.InDelta(t, orthogonal, 0.0, 1e-9)
+}
+
+func TestCosineSimilarityErrors(t *testing.T) {
+	_, err := CosineSimilarity([]float64{0, 0}, []float64{1, 1})
+	assert.ErrorIs(t, err, ErrZeroVector)
+
+	_, err = CosineSimilarity([]float64{1}, []float64{1, 1})
+	assert.ErrorIs(t, err, ErrLengthMismatch)
+}
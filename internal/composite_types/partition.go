@@ -0,0 +1,18 @@
+package composite_types
+
+// Partition splits s into the elements for which pred returns true and those
+// for which it returns false, keeping the original relative order in both.
+// Both results are non nil (but possibly empty) slices, so callers can treat
+// "nothing matched" the same as any other outcome, including when encoding
+// to JSON where a nil slice becomes null rather than [].
+func Partition[T any](s []T, pred func(T) bool) (matching, rest []T) {
+	matching, rest = []T{}, []T{}
+	for _, v := range s {
+		if pred(v) {
+			matching = append(matching, v)
+		} else {
+			rest = append(rest, v)
+		}
+	}
+	return matching, rest
+}
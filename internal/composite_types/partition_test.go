@@ -0,0 +1,37 @@
+package composite_types
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func isEven(n int) bool {
+	return n%2 == 0
+}
+
+func TestPartition(t *testing.T) {
+	input := []int{5, 2, 8, 1, 4, 7, 6}
+	evens, odds := Partition(input, isEven)
+	assert.Equal(t, evens, []int{2, 8, 4, 6})
+	assert.Equal(t, odds, []int{5, 1, 7})
+
+	// Together the outputs are a permutation of the input.
+	combined := append(slices.Clone(evens), odds...)
+	slices.Sort(combined)
+	sorted := slices.Clone(input)
+	slices.Sort(sorted)
+	assert.Equal(t, combined, sorted)
+}
+
+func TestPartitionNonNil(t *testing.T) {
+	evens, odds := Partition([]int{2, 4}, isEven)
+	assert.Equal(t, evens, []int{2, 4})
+	assert.NotNil(t, odds)
+	assert.Empty(t, odds)
+
+	evens, odds = Partition(nil, isEven)
+	assert.NotNil(t, evens)
+	assert.NotNil(t, odds)
+}
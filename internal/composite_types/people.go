@@ -0,0 +1,32 @@
+package composite_types
+
+// Person is a small struct used to compare slices of values with slices of
+// pointers.
+type Person struct {
+	Name string
+	Age  int
+}
+
+// SumValues totals the ages of people stored by value.  A []Person holds every
+// struct back to back in one block of memory, so iterating walks straight
+// through it and the CPU cache prefetches the next elements for free.
+func SumValues(people []Person) int {
+	total := 0
+	for _, p := range people {
+		total += p.Age
+	}
+	return total
+}
+
+// SumPointers totals the ages of people stored by pointer.  A []*Person only
+// keeps the pointers contiguous, each struct is its own allocation that may
+// live anywhere on the heap, so every iteration follows a pointer to a
+// potentially uncached location.  The garbage collector also has one more
+// object per element to track.
+func SumPointers(people []*Person) int {
+	total := 0
+	for _, p := range people {
+		total += p.Age
+	}
+	return total
+}
@@ -0,0 +1,91 @@
+package composite_types
+
+import "sync"
+
+// Pipeline fans the values from in out across `stages` concurrent workers, each
+// applying f, and fans their results back in to a single output channel.  The
+// output channel is closed once in has been drained and every worker is done.
+// Results are emitted as soon as they are ready, so the output order is NOT
+// guaranteed to match the input order.  f must be safe for concurrent use.
+func Pipeline[T, U any](in <-chan T, stages int, f func(T) U) <-chan U {
+	if stages < 1 {
+		stages = 1
+	}
+	out := make(chan U)
+	var wg sync.WaitGroup
+	wg.Add(stages)
+	for i := 0; i < stages; i++ {
+		go func() {
+			defer wg.Done()
+			for v := range in {
+				out <- f(v)
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+	return out
+}
+
+type sequenced[T any] struct {
+	seq   int
+	value T
+}
+
+// OrderedPipeline is like Pipeline, processing values across `workers`
+// concurrent workers, but emits the results in the same order as the input.
+// Each input is tagged with a sequence number, and results that finish early
+// are held in a reordering buffer until every result before them has been
+// sent.  A single slow value therefore holds back everything after it.
+func OrderedPipeline[T, U any](in <-chan T, workers int, f func(T) U) <-chan U {
+	if workers < 1 {
+		workers = 1
+	}
+	jobs := make(chan sequenced[T])
+	go func() {
+		defer close(jobs)
+		seq := 0
+		for v := range in {
+			jobs <- sequenced[T]{seq: seq, value: v}
+			seq++
+		}
+	}()
+
+	results := make(chan sequenced[U])
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer wg.Done()
+			for job := range jobs {
+				results <- sequenced[U]{seq: job.seq, value: f(job.value)}
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	out := make(chan U)
+	go func() {
+		defer close(out)
+		pending := make(map[int]U)
+		next := 0
+		for r := range results {
+			pending[r.seq] = r.value
+			for {
+				v, ok := pending[next]
+				if !ok {
+					break
+				}
+				delete(pending, next)
+				out <- v
+				next++
+			}
+		}
+	}()
+	return out
+}
@@ -0,0 +1,73 @@
+package composite_types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// feed returns a channel that emits 0..n-1 and is then closed.
+func feed(n int) <-chan int {
+	in := make(chan int)
+	go func() {
+		defer close(in)
+		for i := 0; i < n; i++ {
+			in <- i
+		}
+	}()
+	return in
+}
+
+func TestPipelineProcessesEveryInputOnce(t *testing.T) {
+	const n = 1000
+	out := Pipeline(feed(n), 8, func(v int) int { return v * 2 })
+
+	seen := make(map[int]int, n)
+	for v := range out {
+		seen[v]++
+	}
+	// Ranging over out only finishes because the channel was closed.
+	assert.Len(t, seen, n)
+	for i := 0; i < n; i++ {
+		assert.Equal(t, seen[i*2], 1)
+	}
+}
+
+func TestPipelineEmptyInput(t *testing.T) {
+	out := Pipeline(feed(0), 4, func(v int) string { return "never" })
+	_, ok := <-out
+	assert.False(t, ok)
+}
+
+func TestPipelineDefaultsToSingleStage(t *testing.T) {
+	var results []int
+	for v := range Pipeline(feed(5), 0, func(v int) int { return v + 1 }) {
+		results = append(results, v)
+	}
+	// With a single worker the order happens to be preserved.
+	assert.Equal(t, results, []int{1, 2, 3, 4, 5})
+}
+
+func TestOrderedPipelinePreservesOrder(t *testing.T) {
+	const n = 200
+	// Earlier values sleep longer, so they naturally finish last.
+	slow := func(v int) int {
+		time.Sleep(time.Duration((n-v)%7) * time.Millisecond)
+		return v * 10
+	}
+	var results []int
+	for v := range OrderedPipeline(feed(n), 16, slow) {
+		results = append(results, v)
+	}
+	expected := make([]int, n)
+	for i := range expected {
+		expected[i] = i * 10
+	}
+	assert.Equal(t, results, expected)
+}
+
+func TestOrderedPipelineEmptyInput(t *testing.T) {
+	_, ok := <-OrderedPipeline(feed(0), 4, func(v int) int { return v })
+	assert.False(t, ok)
+}
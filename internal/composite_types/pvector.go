@@ -0,0 +1,90 @@
+package composite_types
+
+// pvectorChunkSize is the number of elements held per chunk.
+const pvectorChunkSize = 32
+
+// PVector is a persistent (immutable) vector.  Push, Set and Pop never modify
+// the vector they are called on, they return a new version instead, leaving
+// every previous version intact and usable.
+//
+// Elements are stored in fixed size chunks.  A new version copies the (small)
+// slice of chunk pointers plus the single chunk being changed, every other
+// chunk is shared between the old and new versions.  This copy on write
+// approach costs O(n/32) per update, a simpler cousin of the bit partitioned
+// tries used by persistent vectors in languages like Clojure.
+type PVector[T any] struct {
+	chunks [][]T
+	length int
+}
+
+// Len returns the number of elements in the vector.
+func (v PVector[T]) Len() int {
+	return v.length
+}
+
+// At returns the element at index i, panicking if i is out of range.
+func (v PVector[T]) At(i int) T {
+	if i < 0 || i >= v.length {
+		panic("PVector: index out of range")
+	}
+	return v.chunks[i/pvectorChunkSize][i%pvectorChunkSize]
+}
+
+// Push returns a new vector with x appended.
+func (v PVector[T]) Push(x T) PVector[T] {
+	last := v.length / pvectorChunkSize
+	chunks := make([][]T, last+1)
+	copy(chunks, v.chunks)
+	if last < len(v.chunks) {
+		// The last chunk has room, copy it rather than appending in place,
+		// as appending would write into the array shared with v.
+		chunk := make([]T, len(v.chunks[last]), len(v.chunks[last])+1)
+		copy(chunk, v.chunks[last])
+		chunks[last] = append(chunk, x)
+	} else {
+		chunks[last] = []T{x}
+	}
+	return PVector[T]{chunks: chunks, length: v.length + 1}
+}
+
+// Set returns a new vector with the element at index i replaced by x.
+func (v PVector[T]) Set(i int, x T) PVector[T] {
+	if i < 0 || i >= v.length {
+		panic("PVector: index out of range")
+	}
+	chunks := make([][]T, len(v.chunks))
+	copy(chunks, v.chunks)
+	c := i / pvectorChunkSize
+	chunk := make([]T, len(chunks[c]))
+	copy(chunk, chunks[c])
+	chunk[i%pvectorChunkSize] = x
+	chunks[c] = chunk
+	return PVector[T]{chunks: chunks, length: v.length}
+}
+
+// Pop returns a new vector without its last element, along with that element.
+// Popping an empty vector panics.
+func (v PVector[T]) Pop() (PVector[T], T) {
+	if v.length == 0 {
+		panic("PVector: pop from empty vector")
+	}
+	last := v.At(v.length - 1)
+	length := v.length - 1
+	n := (length + pvectorChunkSize - 1) / pvectorChunkSize
+	chunks := make([][]T, n)
+	copy(chunks, v.chunks)
+	if rem := length % pvectorChunkSize; rem != 0 {
+		// Limit the capacity too, so nothing can append into the shared array.
+		chunks[n-1] = chunks[n-1][:rem:rem]
+	}
+	return PVector[T]{chunks: chunks, length: length}, last
+}
+
+// ToSlice returns the elements as a new slice.
+func (v PVector[T]) ToSlice() []T {
+	out := make([]T, 0, v.length)
+	for _, chunk := range v.chunks {
+		out = append(out, chunk...)
+	}
+	return out
+}
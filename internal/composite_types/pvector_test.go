@@ -0,0 +1,62 @@
+package composite_types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func pvectorOf(n int) PVector[int] {
+	var v PVector[int]
+	for i := 0; i < n; i++ {
+		v = v.Push(i)
+	}
+	return v
+}
+
+func TestPVectorPushLeavesOldVersionIntact(t *testing.T) {
+	base := pvectorOf(3)
+	derived := base.Push(100)
+	other := base.Push(200)
+
+	assert.Equal(t, base.ToSlice(), []int{0, 1, 2})
+	assert.Equal(t, derived.ToSlice(), []int{0, 1, 2, 100})
+	// Pushing twice onto the same version must not clobber each other.
+	assert.Equal(t, other.ToSlice(), []int{0, 1, 2, 200})
+}
+
+func TestPVectorIndexAcrossChunks(t *testing.T) {
+	n := pvectorChunkSize*3 + 5
+	v := pvectorOf(n)
+	assert.Equal(t, v.Len(), n)
+	for i := 0; i < n; i++ {
+		assert.Equal(t, v.At(i), i)
+	}
+	assert.Panics(t, func() { v.At(n) })
+}
+
+func TestPVectorSet(t *testing.T) {
+	v := pvectorOf(pvectorChunkSize * 2)
+	updated := v.Set(pvectorChunkSize, -1)
+	assert.Equal(t, updated.At(pvectorChunkSize), -1)
+	assert.Equal(t, v.At(pvectorChunkSize), pvectorChunkSize)
+	// Untouched chunks are shared, not copied.
+	assert.Same(t, &v.chunks[0][0], &updated.chunks[0][0])
+}
+
+func TestPVectorPop(t *testing.T) {
+	v := pvectorOf(pvectorChunkSize + 1)
+	popped, last := v.Pop()
+	assert.Equal(t, last, pvectorChunkSize)
+	assert.Equal(t, popped.Len(), pvectorChunkSize)
+	assert.Equal(t, v.Len(), pvectorChunkSize+1)
+
+	// Pushing onto a popped version doesn't leak into the original.
+	popped, _ = popped.Pop()
+	pushed := popped.Push(-1)
+	assert.Equal(t, pushed.At(pvectorChunkSize-1), -1)
+	assert.Equal(t, v.At(pvectorChunkSize-1), pvectorChunkSize-1)
+
+	var empty PVector[int]
+	assert.Panics(t, func() { empty.Pop() })
+}
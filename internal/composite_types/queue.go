@@ -0,0 +1,56 @@
+package composite_types
+
+// minQueueCapacity is the size of the buffer allocated by the first Enqueue.
+const minQueueCapacity = 4
+
+// Queue is a first in, first out collection backed by a ring buffer.  The
+// naive approach of appending to a slice and dequeuing with q = q[1:] never
+// reuses the space at the front, and shifting elements down on every dequeue
+// is O(n).  Instead head tracks where the queue starts and the elements wrap
+// around the end of the buffer back to index 0.  When the buffer fills, a
+// buffer twice the size is allocated and the elements copied across in order,
+// so Enqueue is amortised O(1) just like append.  The zero value is an empty
+// queue ready to use.
+type Queue[T any] struct {
+	buf  []T
+	head int
+	size int
+}
+
+// Enqueue adds v to the back of the queue.
+func (q *Queue[T]) Enqueue(v T) {
+	if q.size == len(q.buf) {
+		q.grow()
+	}
+	q.buf[(q.head+q.size)%len(q.buf)] = v
+	q.size++
+}
+
+// Dequeue removes and returns the value at the front of the queue.  An empty
+// queue returns the zero value and false.  The vacated slot is zeroed so the
+// buffer does not keep the value alive.
+func (q *Queue[T]) Dequeue() (T, bool) {
+	var zero T
+	if q.size == 0 {
+		return zero, false
+	}
+	v := q.buf[q.head]
+	q.buf[q.head] = zero
+	q.head = (q.head + 1) % len(q.buf)
+	q.size--
+	return v, true
+}
+
+// Len returns the number of values in the queue.
+func (q *Queue[T]) Len() int {
+	return q.size
+}
+
+// grow doubles the buffer, unwrapping the elements so head starts at 0.
+func (q *Queue[T]) grow() {
+	buf := make([]T, max(len(q.buf)*2, minQueueCapacity))
+	n := copy(buf, q.buf[q.head:])
+	copy(buf[n:], q.buf[:q.head])
+	q.buf = buf
+	q.head = 0
+}
@@ -0,0 +1,63 @@
+package composite_types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQueueFIFO(t *testing.T) {
+	var q Queue[int]
+	for i := 0; i < 10; i++ {
+		q.Enqueue(i)
+	}
+	assert.Equal(t, q.Len(), 10)
+	for i := 0; i < 10; i++ {
+		v, ok := q.Dequeue()
+		assert.True(t, ok)
+		assert.Equal(t, v, i)
+	}
+	assert.Equal(t, q.Len(), 0)
+}
+
+func TestQueueWrapAround(t *testing.T) {
+	var q Queue[int]
+	for i := 1; i <= 4; i++ {
+		q.Enqueue(i)
+	}
+	// Free up the front of the buffer, then enqueue past the end so the
+	// values wrap around to index 0.
+	for want := 1; want <= 3; want++ {
+		v, _ := q.Dequeue()
+		assert.Equal(t, v, want)
+	}
+	q.Enqueue(5)
+	q.Enqueue(6)
+	assert.Equal(t, len(q.buf), minQueueCapacity)
+	assert.Equal(t, q.buf, []int{5, 6, 0, 4})
+
+	// Growing while wrapped keeps the order.
+	q.Enqueue(7)
+	q.Enqueue(8)
+	q.Enqueue(9)
+	assert.Greater(t, len(q.buf), minQueueCapacity)
+
+	var got []int
+	for q.Len() > 0 {
+		v, _ := q.Dequeue()
+		got = append(got, v)
+	}
+	assert.Equal(t, got, []int{4, 5, 6, 7, 8, 9})
+}
+
+func TestQueueEmpty(t *testing.T) {
+	var q Queue[string]
+	v, ok := q.Dequeue()
+	assert.False(t, ok)
+	assert.Equal(t, v, "")
+
+	q.Enqueue("a")
+	_, _ = q.Dequeue()
+	_, ok = q.Dequeue()
+	assert.False(t, ok)
+}
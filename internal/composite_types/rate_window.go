@@ -0,0 +1,55 @@
+package composite_types
+
+import "time"
+
+// RateWindow counts events over a sliding window of time.  Timestamps are held
+// in a ring buffer, new events are written at the tail and expired events are
+// evicted from the head, so neither operation has to shift elements along the
+// slice.  The buffer only grows (doubling) when more events are in the window
+// than it can hold.  Events are expected to be recorded in chronological order.
+type RateWindow struct {
+	window time.Duration
+	events []time.Time
+	head   int
+	size   int
+}
+
+// NewRateWindow returns a RateWindow reporting rates over the given window.
+// The rate is divided by the window, so a window that is not positive has no
+// meaningful rate and panics.
+func NewRateWindow(window time.Duration) *RateWindow {
+	if window <= 0 {
+		panic("NewRateWindow: window must be positive")
+	}
+	return &RateWindow{window: window, events: make([]time.Time, 8)}
+}
+
+// Record registers an event that happened at t.
+func (r *RateWindow) Record(t time.Time) {
+	if r.size == len(r.events) {
+		r.grow()
+	}
+	r.events[(r.head+r.size)%len(r.events)] = t
+	r.size++
+}
+
+// RatePerSecond evicts events that fall outside of the window ending at now
+// and returns the remaining event count as a per second rate.
+func (r *RateWindow) RatePerSecond(now time.Time) float64 {
+	cutoff := now.Add(-r.window)
+	for r.size > 0 && !r.events[r.head].After(cutoff) {
+		r.head = (r.head + 1) % len(r.events)
+		r.size--
+	}
+	return float64(r.size) / r.window.Seconds()
+}
+
+// grow doubles the ring, copying the events back into order from index 0.
+func (r *RateWindow) grow() {
+	events := make([]time.Time, len(r.events)*2)
+	for i := 0; i < r.size; i++ {
+		events[i] = r.events[(r.head+i)%len(r.events)]
+	}
+	r.events = events
+	r.head = 0
+}
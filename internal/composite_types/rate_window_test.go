@@ -0,0 +1,48 @@
+package composite_types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var epoch = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func TestRateWindowEmpty(t *testing.T) {
+	r := NewRateWindow(time.Second)
+	assert.Zero(t, r.RatePerSecond(epoch))
+}
+
+func TestRateWindowRate(t *testing.T) {
+	r := NewRateWindow(10 * time.Second)
+	// 20 events, one every 500ms over the first 10 seconds.
+	for i := 0; i < 20; i++ {
+		r.Record(epoch.Add(time.Duration(i) * 500 * time.Millisecond))
+	}
+	assert.Equal(t, r.RatePerSecond(epoch.Add(9500*time.Millisecond)), 2.0)
+
+	// 5 seconds later, only the events after 4.5s remain in the window.
+	assert.Equal(t, r.RatePerSecond(epoch.Add(14500*time.Millisecond)), 1.0)
+
+	// Long after, everything has been evicted.
+	assert.Zero(t, r.RatePerSecond(epoch.Add(time.Minute)))
+}
+
+func TestRateWindowWrapsRingBuffer(t *testing.T) {
+	r := NewRateWindow(time.Second)
+	// Repeatedly record and evict so the head and tail lap the ring
+	// without it ever needing to grow.
+	for i := 0; i < 100; i++ {
+		now := epoch.Add(time.Duration(i) * time.Second)
+		r.Record(now)
+		r.Record(now.Add(time.Millisecond))
+		assert.Equal(t, r.RatePerSecond(now.Add(2*time.Millisecond)), 2.0)
+	}
+	assert.Len(t, r.events, 8)
+}
+
+func TestRateWindowInvalidWindowPanics(t *testing.T) {
+	assert.Panics(t, func() { NewRateWindow(0) })
+	assert.Panics(t, func() { NewRateWindow(-time.Second) })
+}
@@ -0,0 +1,38 @@
+package composite_types
+
+import "slices"
+
+// ReadOnly is an immutable view over a slice.  Slices share their backing
+// array, so handing one out lets the receiver change data the owner still
+// holds (and vice versa).  ReadOnly only exposes methods that read.
+type ReadOnly[T any] struct {
+	items []T
+}
+
+// NewReadOnly copies s into a new ReadOnly.  The copy is what makes the view
+// safe, later writes to s cannot reach it, but it costs an allocation and an
+// O(n) copy up front, so it suits data that is shared widely rather than
+// slices rebuilt on every call.  Note the copy is shallow, if T holds
+// pointers, maps or slices the values they refer to are still shared.
+func NewReadOnly[T any](s []T) ReadOnly[T] {
+	return ReadOnly[T]{items: slices.Clone(s)}
+}
+
+// At returns the element at index i, panicking if i is out of range just
+// like indexing a slice would.
+func (r ReadOnly[T]) At(i int) T {
+	return r.items[i]
+}
+
+// Len returns the number of elements in the view.
+func (r ReadOnly[T]) Len() int {
+	return len(r.items)
+}
+
+// ForEach calls f with every element in order.  f receives a copy of each
+// element, so cannot modify the view.
+func (r ReadOnly[T]) ForEach(f func(T)) {
+	for _, item := range r.items {
+		f(item)
+	}
+}
@@ -0,0 +1,32 @@
+package composite_types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadOnlyUnaffectedByOriginal(t *testing.T) {
+	original := []int{1, 2, 3}
+	view := NewReadOnly(original)
+
+	original[0] = 100
+	original = append(original, 4)
+	assert.Equal(t, view.Len(), 3)
+	assert.Equal(t, view.At(0), 1)
+
+	var seen []int
+	view.ForEach(func(v int) { seen = append(seen, v) })
+	assert.Equal(t, seen, []int{1, 2, 3})
+}
+
+func TestReadOnlyAtOutOfRange(t *testing.T) {
+	view := NewReadOnly([]string{"a"})
+	assert.Panics(t, func() { view.At(1) })
+}
+
+func TestReadOnlyEmpty(t *testing.T) {
+	view := NewReadOnly[int](nil)
+	assert.Equal(t, view.Len(), 0)
+	view.ForEach(func(int) { t.Fatal("no elements expected") })
+}
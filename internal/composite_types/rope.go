@@ -0,0 +1,133 @@
+package composite_types
+
+import (
+	"math/bits"
+	"strings"
+)
+
+// ropeLeafSize is the maximum number of runes stored in a single leaf.
+const ropeLeafSize = 64
+
+// Rope represents a (potentially very large) string as a binary tree of small
+// chunks.  Strings in go are immutable, so concatenating two large strings
+// copies both of them into a brand new string.  Concatenating ropes instead
+// just creates a new parent node pointing at the two existing trees, and
+// taking a substring shares the existing chunks rather than copying them.
+// Ropes are immutable, every operation returns a new Rope.  All indexes are
+// in runes, not bytes.
+type Rope struct {
+	left, right *Rope
+	leaf        []rune
+	length      int
+	depth       int
+	leafCount   int
+}
+
+// NewRope returns a balanced Rope holding s.
+func NewRope(s string) *Rope {
+	runes := []rune(s)
+	var leaves []*Rope
+	for len(runes) > ropeLeafSize {
+		leaves = append(leaves, newLeaf(runes[:ropeLeafSize:ropeLeafSize]))
+		runes = runes[ropeLeafSize:]
+	}
+	leaves = append(leaves, newLeaf(runes))
+	return buildRope(leaves)
+}
+
+func newLeaf(runes []rune) *Rope {
+	return &Rope{leaf: runes, length: len(runes), leafCount: 1}
+}
+
+func newNode(left, right *Rope) *Rope {
+	return &Rope{
+		left:      left,
+		right:     right,
+		length:    left.length + right.length,
+		depth:     max(left.depth, right.depth) + 1,
+		leafCount: left.leafCount + right.leafCount,
+	}
+}
+
+// buildRope builds a balanced tree from leaves, which must not be empty.
+func buildRope(leaves []*Rope) *Rope {
+	if len(leaves) == 1 {
+		return leaves[0]
+	}
+	mid := len(leaves) / 2
+	return newNode(buildRope(leaves[:mid]), buildRope(leaves[mid:]))
+}
+
+// Len returns the length of the rope in runes.
+func (r *Rope) Len() int {
+	return r.length
+}
+
+// String flattens the rope back into a string.
+func (r *Rope) String() string {
+	var b strings.Builder
+	for _, leaf := range r.leaves(nil) {
+		for _, c := range leaf.leaf {
+			b.WriteRune(c)
+		}
+	}
+	return b.String()
+}
+
+// Concat returns a new rope of r followed by other.  Neither is modified.  If
+// repeated concatenation leaves the tree too lopsided it is rebalanced.
+func (r *Rope) Concat(other *Rope) *Rope {
+	if r.length == 0 {
+		return other
+	}
+	if other.length == 0 {
+		return r
+	}
+	joined := newNode(r, other)
+	if joined.depth > 2*bits.Len(uint(joined.leafCount)) {
+		return buildRope(joined.leaves(nil))
+	}
+	return joined
+}
+
+// SubString returns a rope of the runes in [start, end), sharing the chunks
+// of r.  Like slicing, it panics if the indexes are out of range.
+func (r *Rope) SubString(start, end int) *Rope {
+	if start < 0 || end > r.length || start > end {
+		panic("Rope: substring out of range")
+	}
+	leaves := r.slice(start, end, nil)
+	if len(leaves) == 0 {
+		return newLeaf(nil)
+	}
+	return buildRope(leaves)
+}
+
+// slice appends leaves covering runes [start, end) of r to out.
+func (r *Rope) slice(start, end int, out []*Rope) []*Rope {
+	if start >= end {
+		return out
+	}
+	if r.left == nil {
+		return append(out, newLeaf(r.leaf[start:end:end]))
+	}
+	split := r.left.length
+	if start < split {
+		out = r.left.slice(start, min(end, split), out)
+	}
+	if end > split {
+		out = r.right.slice(max(start-split, 0), end-split, out)
+	}
+	return out
+}
+
+// leaves appends every non empty leaf of r, in order, to out.
+func (r *Rope) leaves(out []*Rope) []*Rope {
+	if r.left == nil {
+		if r.length > 0 {
+			out = append(out, r)
+		}
+		return out
+	}
+	return r.right.leaves(r.left.leaves(out))
+}
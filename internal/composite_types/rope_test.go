@@ -0,0 +1,62 @@
+package composite_types
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRopeRoundTrip(t *testing.T) {
+	for _, s := range []string{"", "hello", "hello ॡ world", strings.Repeat("abcॡ", 100)} {
+		r := NewRope(s)
+		assert.Equal(t, r.String(), s)
+		assert.Equal(t, r.Len(), len([]rune(s)))
+	}
+}
+
+func TestRopeConcat(t *testing.T) {
+	parts := []string{"The ", "quick ", "", "brown ॡ ", "fox"}
+	r := NewRope(parts[0])
+	for _, p := range parts[1:] {
+		r = r.Concat(NewRope(p))
+	}
+	assert.Equal(t, r.String(), "The quick brown ॡ fox")
+	assert.Equal(t, r.Len(), 21)
+}
+
+func TestRopeConcatLeavesOperandsUntouched(t *testing.T) {
+	a, b := NewRope("foo"), NewRope("bar")
+	joined := a.Concat(b)
+	assert.Equal(t, joined.String(), "foobar")
+	assert.Equal(t, a.String(), "foo")
+	assert.Equal(t, b.String(), "bar")
+}
+
+func TestRopeStaysBalanced(t *testing.T) {
+	r := NewRope("")
+	for i := 0; i < 1000; i++ {
+		r = r.Concat(NewRope("x"))
+	}
+	assert.Equal(t, r.Len(), 1000)
+	// 1000 leaves, a balanced tree is ~10 deep, a degenerate one 1000.
+	assert.LessOrEqual(t, r.depth, 2*11)
+}
+
+func TestRopeSubStringAcrossChunks(t *testing.T) {
+	// Build from small pieces so chunk boundaries fall every few runes.
+	r := NewRope("ab").Concat(NewRope("ॡॡ")).Concat(NewRope("cd")).Concat(NewRope("éf"))
+	assert.Equal(t, r.SubString(1, 7).String(), "bॡॡcdé")
+	assert.Equal(t, r.SubString(2, 4).String(), "ॡॡ")
+	assert.Equal(t, r.SubString(0, r.Len()).String(), "abॡॡcdéf")
+	assert.Equal(t, r.SubString(3, 3).String(), "")
+
+	long := NewRope(strings.Repeat("0123456789", 20))
+	assert.Equal(t, long.SubString(60, 70).String(), "0123456789")
+}
+
+func TestRopeSubStringOutOfRange(t *testing.T) {
+	r := NewRope("abc")
+	assert.Panics(t, func() { r.SubString(2, 4) })
+	assert.Panics(t, func() { r.SubString(2, 1) })
+}
@@ -0,0 +1,40 @@
+package composite_types
+
+import "unicode/utf8"
+
+// DecodeRunes decodes b one rune at a time, returning the runes along with the
+// number of bytes which were not valid UTF-8.  A plain []rune(string(b))
+// conversion silently swaps bad bytes for utf8.RuneError (U+FFFD), making it
+// impossible to tell them apart from a genuine U+FFFD in the input.
+// utf8.DecodeRune reports an invalid byte with a size of 1, whereas a real
+// U+FFFD is 3 bytes long, which is how the two are distinguished here.
+// Each invalid byte still contributes a utf8.RuneError to runes.
+func DecodeRunes(b []byte) (runes []rune, invalid int) {
+	runes = make([]rune, 0, utf8.RuneCount(b))
+	for len(b) > 0 {
+		r, size := utf8.DecodeRune(b)
+		if r == utf8.RuneError && size == 1 {
+			invalid++
+		}
+		runes = append(runes, r)
+		b = b[size:]
+	}
+	return runes, invalid
+}
+
+// RuneSlices splits s into the bytes making up each of its runes, so the
+// result shows how many bytes every "character" occupies, from 1 for ASCII up
+// to 4.  s is converted to bytes once and each element is a sub slice of that
+// one array.  The full slice expression caps every sub slice at its own end,
+// so appending to one cannot overwrite the bytes of the next.  Invalid UTF-8
+// bytes are returned as 1 byte slices.
+func RuneSlices(s string) [][]byte {
+	b := []byte(s)
+	out := make([][]byte, 0, utf8.RuneCount(b))
+	for i := 0; i < len(b); {
+		_, size := utf8.DecodeRune(b[i:])
+		out = append(out, b[i:i+size:i+size])
+		i += size
+	}
+	return out
+}
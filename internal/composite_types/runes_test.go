@@ -0,0 +1,54 @@
+package composite_types
+
+import (
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeRunesValid(t *testing.T) {
+	runes, invalid := DecodeRunes([]byte("Hi ॡ"))
+	assert.Equal(t, invalid, 0)
+	assert.Equal(t, runes, []rune{'H', 'i', ' ', 'ॡ'})
+
+	// A real U+FFFD in the input is not counted as invalid.
+	runes, invalid = DecodeRunes([]byte("a�b"))
+	assert.Equal(t, invalid, 0)
+	assert.Equal(t, runes, []rune{'a', utf8.RuneError, 'b'})
+}
+
+func TestDecodeRunesTruncated(t *testing.T) {
+	// ॡ is three bytes, drop the last one to leave a truncated sequence.
+	b := []byte("ॡ")
+	truncated := append([]byte("ok"), b[:2]...)
+	runes, invalid := DecodeRunes(truncated)
+	assert.Equal(t, invalid, 2)
+	assert.Equal(t, runes, []rune{'o', 'k', utf8.RuneError, utf8.RuneError})
+}
+
+func TestDecodeRunesEmpty(t *testing.T) {
+	runes, invalid := DecodeRunes(nil)
+	assert.Equal(t, invalid, 0)
+	assert.Empty(t, runes)
+}
+
+func TestRuneSlices(t *testing.T) {
+	parts := RuneSlices("Hi ॡ")
+	assert.Len(t, parts, 4)
+	assert.Equal(t, parts[0], []byte("H"))
+	assert.Equal(t, parts[2], []byte(" "))
+	// The Devanagari rune needs 3 bytes, the ASCII runes only 1.
+	assert.Len(t, parts[3], 3)
+	assert.Equal(t, string(parts[3]), "ॡ")
+	for _, ascii := range parts[:3] {
+		assert.Len(t, ascii, 1)
+	}
+}
+
+func TestRuneSlicesAppendIsolated(t *testing.T) {
+	parts := RuneSlices("ab")
+	_ = append(parts[0], 'z')
+	assert.Equal(t, parts[1], []byte("b"))
+	assert.Empty(t, RuneSlices(""))
+}
@@ -0,0 +1,50 @@
+package composite_types
+
+import "math/rand"
+
+// StreamSampler keeps a uniform random sample of up to k distinct values from
+// a stream of unknown length, using reservoir sampling.  Duplicate values are
+// only counted the first time they are seen, so a value that appears many
+// times in the stream is no more likely to be sampled than one appearing once.
+// Note: tracking which values have been seen requires a set that grows with
+// the number of distinct values, only the sample itself is bounded by k.
+type StreamSampler[T comparable] struct {
+	k      int
+	rng    *rand.Rand
+	seen   map[T]struct{}
+	sample []T
+}
+
+// NewStreamSampler returns a sampler holding at most k values.  The seed makes
+// the sampling reproducible, which is handy for tests.
+func NewStreamSampler[T comparable](k int, seed int64) *StreamSampler[T] {
+	return &StreamSampler[T]{
+		k:      k,
+		rng:    rand.New(rand.NewSource(seed)),
+		seen:   make(map[T]struct{}),
+		sample: make([]T, 0, max(k, 0)),
+	}
+}
+
+// Observe feeds the next value of the stream into the sampler.
+func (s *StreamSampler[T]) Observe(v T) {
+	if _, ok := s.seen[v]; ok {
+		return
+	}
+	s.seen[v] = struct{}{}
+	if len(s.sample) < s.k {
+		s.sample = append(s.sample, v)
+		return
+	}
+	// The n'th distinct value replaces a random slot with probability k/n.
+	if j := s.rng.Intn(len(s.seen)); j < s.k {
+		s.sample[j] = v
+	}
+}
+
+// Sample returns a copy of the values currently sampled.
+func (s *StreamSampler[T]) Sample() []T {
+	out := make([]T, len(s.sample))
+	copy(out, s.sample)
+	return out
+}
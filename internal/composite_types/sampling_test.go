@@ -0,0 +1,61 @@
+package composite_types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStreamSamplerDeterministic(t *testing.T) {
+	run := func() []int {
+		s := NewStreamSampler[int](3, 42)
+		for i := 0; i < 100; i++ {
+			s.Observe(i)
+		}
+		return s.Sample()
+	}
+	first := run()
+	assert.Len(t, first, 3)
+	// The same seed over the same stream always yields the same sample.
+	assert.Equal(t, run(), first)
+	for _, v := range first {
+		assert.True(t, v >= 0 && v < 100)
+	}
+}
+
+func TestStreamSamplerFewerThanK(t *testing.T) {
+	s := NewStreamSampler[string](5, 1)
+	for _, v := range []string{"a", "b", "a", "c", "b"} {
+		s.Observe(v)
+	}
+	assert.ElementsMatch(t, s.Sample(), []string{"a", "b", "c"})
+}
+
+func TestStreamSamplerDuplicatesDoNotOverRepresent(t *testing.T) {
+	// 0 dominates the stream, but is only one of ten distinct values so
+	// should be sampled roughly 10% of the time, not ~99%.
+	const trials = 2000
+	zeroes := 0
+	for seed := int64(0); seed < trials; seed++ {
+		s := NewStreamSampler[int](1, seed)
+		for i := 1; i < 10; i++ {
+			for j := 0; j < 100; j++ {
+				s.Observe(0)
+			}
+			s.Observe(i)
+		}
+		if s.Sample()[0] == 0 {
+			zeroes++
+		}
+	}
+	assert.InDelta(t, float64(zeroes)/trials, 0.1, 0.03)
+}
+
+func TestStreamSamplerSampleIsACopy(t *testing.T) {
+	s := NewStreamSampler[int](2, 1)
+	s.Observe(1)
+	s.Observe(2)
+	sample := s.Sample()
+	sample[0] = 100
+	assert.NotContains(t, s.Sample(), 100)
+}
@@ -0,0 +1,15 @@
+package composite_types
+
+import (
+	"cmp"
+	"slices"
+)
+
+// SearchInsert looks for target in s using a binary search.  When found, index
+// is its position, otherwise index is where target would need to be inserted
+// to keep s sorted, which may be len(s).  s must already be sorted in
+// ascending order, a binary search on unsorted data returns meaningless
+// results rather than an error.
+func SearchInsert[T cmp.Ordered](s []T, target T) (index int, found bool) {
+	return slices.BinarySearch(s, target)
+}
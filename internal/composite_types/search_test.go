@@ -0,0 +1,40 @@
+package composite_types
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchInsertFound(t *testing.T) {
+	index, found := SearchInsert([]int{1, 3, 5, 7}, 5)
+	assert.True(t, found)
+	assert.Equal(t, index, 2)
+}
+
+func TestSearchInsertBounds(t *testing.T) {
+	s := []int{10, 20, 30}
+	index, found := SearchInsert(s, 1)
+	assert.False(t, found)
+	assert.Equal(t, index, 0)
+
+	index, found = SearchInsert(s, 99)
+	assert.False(t, found)
+	assert.Equal(t, index, len(s))
+
+	index, found = SearchInsert([]int{}, 5)
+	assert.False(t, found)
+	assert.Equal(t, index, 0)
+}
+
+func TestSearchInsertInterior(t *testing.T) {
+	s := []string{"apple", "cherry", "grape"}
+	index, found := SearchInsert(s, "banana")
+	assert.False(t, found)
+	assert.Equal(t, index, 1)
+
+	// Inserting at the returned index keeps the slice sorted.
+	s = slices.Insert(s, index, "banana")
+	assert.True(t, slices.IsSorted(s))
+}
@@ -0,0 +1,30 @@
+package composite_types
+
+// Set is a collection of unique values, built on a map with empty struct
+// values.  struct{} takes up no memory, so only the keys cost anything.
+type Set[T comparable] map[T]struct{}
+
+// NewSet returns a Set holding the given items.
+func NewSet[T comparable](items ...T) Set[T] {
+	s := make(Set[T], len(items))
+	for _, item := range items {
+		s.Add(item)
+	}
+	return s
+}
+
+// Add inserts v into the set, adding an existing value is a no-op.
+func (s Set[T]) Add(v T) {
+	s[v] = struct{}{}
+}
+
+// Contains reports whether v is in the set.
+func (s Set[T]) Contains(v T) bool {
+	_, ok := s[v]
+	return ok
+}
+
+// Len returns the number of values in the set.
+func (s Set[T]) Len() int {
+	return len(s)
+}
@@ -0,0 +1,24 @@
+package composite_types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSet(t *testing.T) {
+	s := NewSet("a", "b", "a")
+	assert.Equal(t, s.Len(), 2)
+	assert.True(t, s.Contains("a"))
+	assert.False(t, s.Contains("z"))
+
+	s.Add("z")
+	assert.True(t, s.Contains("z"))
+	assert.Equal(t, s.Len(), 3)
+}
+
+func TestEmptySet(t *testing.T) {
+	s := NewSet[int]()
+	assert.Zero(t, s.Len())
+	assert.False(t, s.Contains(0))
+}
@@ -0,0 +1,67 @@
+package composite_types
+
+import "sync"
+
+type shard[K comparable, V any] struct {
+	mu sync.RWMutex
+	m  map[K]V
+}
+
+// ShardedMap is a map that is safe for concurrent use.  Rather than guarding
+// one map with a single mutex, which every goroutine would contend on, keys are
+// spread across independently locked shards using HashKey.  Goroutines working
+// on keys in different shards never block each other.
+type ShardedMap[K comparable, V any] struct {
+	shards []*shard[K, V]
+}
+
+// NewShardedMap returns a ShardedMap split across n shards (at least one).
+func NewShardedMap[K comparable, V any](n int) *ShardedMap[K, V] {
+	n = max(n, 1)
+	shards := make([]*shard[K, V], n)
+	for i := range shards {
+		shards[i] = &shard[K, V]{m: make(map[K]V)}
+	}
+	return &ShardedMap[K, V]{shards: shards}
+}
+
+func (s *ShardedMap[K, V]) shardFor(key K) *shard[K, V] {
+	return s.shards[HashKey(key)%uint64(len(s.shards))]
+}
+
+// Set stores value under key.
+func (s *ShardedMap[K, V]) Set(key K, value V) {
+	sh := s.shardFor(key)
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	sh.m[key] = value
+}
+
+// Get returns the value for key and whether it was present.
+func (s *ShardedMap[K, V]) Get(key K) (V, bool) {
+	sh := s.shardFor(key)
+	sh.mu.RLock()
+	defer sh.mu.RUnlock()
+	v, ok := sh.m[key]
+	return v, ok
+}
+
+// Delete removes key.
+func (s *ShardedMap[K, V]) Delete(key K) {
+	sh := s.shardFor(key)
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	delete(sh.m, key)
+}
+
+// Len returns the total number of keys.  Each shard is locked in turn, so with
+// concurrent writers the result is a best effort snapshot.
+func (s *ShardedMap[K, V]) Len() int {
+	total := 0
+	for _, sh := range s.shards {
+		sh.mu.RLock()
+		total += len(sh.m)
+		sh.mu.RUnlock()
+	}
+	return total
+}
@@ -0,0 +1,53 @@
+package composite_types
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShardedMapBasics(t *testing.T) {
+	m := NewShardedMap[string, int](4)
+	m.Set("a", 1)
+	m.Set("b", 2)
+	v, ok := m.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, v, 1)
+	assert.Equal(t, m.Len(), 2)
+
+	m.Delete("a")
+	_, ok = m.Get("a")
+	assert.False(t, ok)
+	assert.Equal(t, m.Len(), 1)
+}
+
+func TestShardedMapConcurrentWriters(t *testing.T) {
+	const goroutines, perGoroutine = 16, 200
+	m := NewShardedMap[int, int](8)
+
+	var wg sync.WaitGroup
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < perGoroutine; i++ {
+				key := g*perGoroutine + i
+				m.Set(key, key*2)
+				_, _ = m.Get(key)
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	assert.Equal(t, m.Len(), goroutines*perGoroutine)
+	for key := 0; key < goroutines*perGoroutine; key++ {
+		v, ok := m.Get(key)
+		assert.True(t, ok)
+		assert.Equal(t, v, key*2)
+	}
+	// The keys are spread across more than one shard.
+	for _, sh := range m.shards {
+		assert.NotEmpty(t, sh.m)
+	}
+}
@@ -0,0 +1,17 @@
+package composite_types
+
+import "fmt"
+
+// SliceMetadata predicts the length and capacity of s[low:high] without
+// slicing.  The length is simply high - low, while the capacity runs from low
+// to the end of the backing array, cap(s) - low, not len(s) - low.  That spare
+// capacity is why appending to a sub slice can overwrite elements of its
+// parent, see TestFunkySlicingAppendCapacity.  high may go beyond len(s) up to
+// cap(s), just like a real slice expression, invalid indices panic as slicing
+// would.
+func SliceMetadata[T any](s []T, low, high int) (length, capacity int) {
+	if low < 0 || high < low || high > cap(s) {
+		panic(fmt.Sprintf("slice bounds out of range [%d:%d] with capacity %d", low, high, cap(s)))
+	}
+	return high - low, cap(s) - low
+}
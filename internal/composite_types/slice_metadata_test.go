@@ -0,0 +1,35 @@
+package composite_types
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSliceMetadataMatchesSlicing(t *testing.T) {
+	s := make([]string, 4, 6)
+	for _, bounds := range [][2]int{{0, 0}, {0, 2}, {1, 3}, {2, 4}, {4, 4}, {0, 6}, {3, 5}, {6, 6}} {
+		low, high := bounds[0], bounds[1]
+		sub := s[low:high]
+		length, capacity := SliceMetadata(s, low, high)
+		name := fmt.Sprintf("s[%d:%d]", low, high)
+		assert.Equal(t, length, len(sub), name)
+		assert.Equal(t, capacity, cap(sub), name)
+	}
+}
+
+func TestSliceMetadataFunkySlicing(t *testing.T) {
+	s := []string{"A", "B", "C", "D"}
+	length, capacity := SliceMetadata(s, 0, 2)
+	assert.Equal(t, length, 2)
+	// Two spare slots, the first append to s[:2] overwrites s[2].
+	assert.Equal(t, capacity, 4)
+}
+
+func TestSliceMetadataOutOfRange(t *testing.T) {
+	s := make([]int, 2, 3)
+	assert.Panics(t, func() { SliceMetadata(s, 0, 4) })
+	assert.Panics(t, func() { SliceMetadata(s, 2, 1) })
+	assert.Panics(t, func() { SliceMetadata(s, -1, 1) })
+}
@@ -0,0 +1,47 @@
+package composite_types
+
+// Stack is a last in, first out collection backed by a slice, the top of the
+// stack being the end of the slice.  The zero value is an empty stack ready
+// to use.
+type Stack[T any] struct {
+	items []T
+}
+
+// Push places v on top of the stack.
+func (s *Stack[T]) Push(v T) {
+	s.items = append(s.items, v)
+}
+
+// Pop removes and returns the value on top of the stack.  An empty stack
+// returns the zero value and false.
+//
+// Shrinking the slice alone would leave the popped value in the backing
+// array beyond len, where the garbage collector still sees it.  If T holds a
+// pointer, whatever it points to could never be freed while the stack lives,
+// so the slot is zeroed first.
+func (s *Stack[T]) Pop() (T, bool) {
+	var zero T
+	if len(s.items) == 0 {
+		return zero, false
+	}
+	last := len(s.items) - 1
+	v := s.items[last]
+	s.items[last] = zero
+	s.items = s.items[:last]
+	return v, true
+}
+
+// Peek returns the value on top of the stack without removing it.  An empty
+// stack returns the zero value and false.
+func (s *Stack[T]) Peek() (T, bool) {
+	if len(s.items) == 0 {
+		var zero T
+		return zero, false
+	}
+	return s.items[len(s.items)-1], true
+}
+
+// Len returns the number of values on the stack.
+func (s *Stack[T]) Len() int {
+	return len(s.items)
+}
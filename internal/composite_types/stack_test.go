@@ -0,0 +1,51 @@
+package composite_types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStackLIFO(t *testing.T) {
+	var s Stack[int]
+	for i := 1; i <= 3; i++ {
+		s.Push(i)
+	}
+	assert.Equal(t, s.Len(), 3)
+
+	top, ok := s.Peek()
+	assert.True(t, ok)
+	assert.Equal(t, top, 3)
+	assert.Equal(t, s.Len(), 3)
+
+	var popped []int
+	for s.Len() > 0 {
+		v, ok := s.Pop()
+		assert.True(t, ok)
+		popped = append(popped, v)
+	}
+	assert.Equal(t, popped, []int{3, 2, 1})
+}
+
+func TestStackEmpty(t *testing.T) {
+	var s Stack[string]
+	v, ok := s.Pop()
+	assert.False(t, ok)
+	assert.Equal(t, v, "")
+
+	v, ok = s.Peek()
+	assert.False(t, ok)
+	assert.Equal(t, v, "")
+}
+
+func TestStackPopZeroesSlot(t *testing.T) {
+	var s Stack[*int]
+	value := 42
+	s.Push(&value)
+	s.Push(&value)
+	_, _ = s.Pop()
+	// Reslice up to the capacity to peek at the slot beyond len.
+	backing := s.items[:cap(s.items)]
+	assert.Nil(t, backing[1])
+	assert.Equal(t, backing[0], &value)
+}
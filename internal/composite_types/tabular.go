@@ -0,0 +1,41 @@
+package composite_types
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrColumnOutOfRange is returned when a requested column index does not
+	// exist in the rows being processed.
+	ErrColumnOutOfRange = errors.New("column index out of range")
+	// ErrRaggedRow is returned when a row does not have the same number of
+	// columns as the first row.
+	ErrRaggedRow = errors.New("ragged row")
+)
+
+// Pivot groups rows by the value found in keyCol, collecting the value in valCol
+// for each row under that key.  Values for a key are kept in the order they
+// appear in rows.  This is a nice real world example of slices and maps
+// working together, a map of string -> []string.
+func Pivot(rows [][]string, keyCol, valCol int) (map[string][]string, error) {
+	pivoted := make(map[string][]string)
+	if len(rows) == 0 {
+		return pivoted, nil
+	}
+	width := len(rows[0])
+	if keyCol < 0 || keyCol >= width {
+		return nil, fmt.Errorf("%w: key column %d (width %d)", ErrColumnOutOfRange, keyCol, width)
+	}
+	if valCol < 0 || valCol >= width {
+		return nil, fmt.Errorf("%w: value column %d (width %d)", ErrColumnOutOfRange, valCol, width)
+	}
+	for i, row := range rows {
+		if len(row) != width {
+			return nil, fmt.Errorf("%w: row %d has %d columns, expected %d", ErrRaggedRow, i, len(row), width)
+		}
+		key := row[keyCol]
+		pivoted[key] = append(pivoted[key], row[valCol])
+	}
+	return pivoted, nil
+}
@@ -0,0 +1,52 @@
+package composite_types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var fruitSales = [][]string{
+	{"apple", "monday", "10"},
+	{"pear", "monday", "3"},
+	{"apple", "tuesday", "7"},
+	{"banana", "tuesday", "12"},
+	{"apple", "wednesday", "1"},
+}
+
+func TestPivot(t *testing.T) {
+	pivoted, err := Pivot(fruitSales, 0, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, pivoted, map[string][]string{
+		"apple":  {"10", "7", "1"},
+		"pear":   {"3"},
+		"banana": {"12"},
+	})
+
+	byDay, err := Pivot(fruitSales, 1, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, byDay["tuesday"], []string{"apple", "banana"})
+}
+
+func TestPivotEmpty(t *testing.T) {
+	pivoted, err := Pivot(nil, 0, 1)
+	assert.NoError(t, err)
+	assert.Empty(t, pivoted)
+}
+
+func TestPivotColumnOutOfRange(t *testing.T) {
+	_, err := Pivot(fruitSales, 0, 3)
+	assert.ErrorIs(t, err, ErrColumnOutOfRange)
+
+	_, err = Pivot(fruitSales, -1, 2)
+	assert.ErrorIs(t, err, ErrColumnOutOfRange)
+}
+
+func TestPivotRaggedRows(t *testing.T) {
+	ragged := [][]string{
+		{"apple", "10"},
+		{"pear"},
+	}
+	_, err := Pivot(ragged, 0, 1)
+	assert.ErrorIs(t, err, ErrRaggedRow)
+}
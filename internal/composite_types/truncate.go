@@ -0,0 +1,15 @@
+package composite_types
+
+// Truncate empties s while keeping its capacity for reuse, zeroing the old
+// elements on the way.  Compare the two building blocks it combines:
+//
+//   - clear(s) zeroes every element but leaves the length untouched.
+//   - s[:0] sets the length to zero but leaves the old elements sitting in
+//     the backing array, where any pointers they hold keep memory alive.
+//
+// Truncate does both, the returned slice has length 0 and the original
+// capacity, and nothing stale is left reachable from it.
+func Truncate[T any](s []T) []T {
+	clear(s)
+	return s[:0]
+}
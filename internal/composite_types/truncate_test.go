@@ -0,0 +1,34 @@
+package composite_types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTruncate(t *testing.T) {
+	s := []int{1, 2, 3}
+	truncated := Truncate(s)
+	assert.Len(t, truncated, 0)
+	assert.Equal(t, cap(truncated), 3)
+	// The backing array is shared, so s shows the zeroed elements.
+	assert.Equal(t, s, []int{0, 0, 0})
+
+	// Appending reuses the capacity rather than allocating.
+	truncated = append(truncated, 9)
+	assert.Same(t, &truncated[0], &s[0])
+}
+
+func TestTruncateVersusReslice(t *testing.T) {
+	value := 1
+	resliced := []*int{&value}[:0]
+	// A plain reslice still holds the pointer past len.
+	assert.Equal(t, resliced[:1][0], &value)
+
+	truncated := Truncate([]*int{&value})
+	assert.Nil(t, truncated[:1][0])
+}
+
+func TestTruncateNil(t *testing.T) {
+	assert.Empty(t, Truncate[int](nil))
+}
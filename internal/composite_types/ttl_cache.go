@@ -0,0 +1,89 @@
+package composite_types
+
+import (
+	"container/list"
+	"time"
+)
+
+// TTLCache is a least recently used cache whose entries also expire a fixed
+// duration after they were set.  A map gives O(1) lookup of the list element
+// for a key, and the doubly linked list keeps entries ordered from most to
+// least recently used so the eviction victim is always at the back.
+// It is not safe for concurrent use.
+type TTLCache[K comparable, V any] struct {
+	capacity int
+	ttl      time.Duration
+	now      func() time.Time
+	entries  map[K]*list.Element
+	order    *list.List
+}
+
+type ttlEntry[K comparable, V any] struct {
+	key     K
+	value   V
+	expires time.Time
+}
+
+// NewTTLCache returns a cache holding at most capacity entries, each living for
+// ttl.  now is the clock used to stamp and check expiry, tests can inject a fake
+// clock here; nil uses time.Now.
+func NewTTLCache[K comparable, V any](capacity int, ttl time.Duration, now func() time.Time) *TTLCache[K, V] {
+	if now == nil {
+		now = time.Now
+	}
+	return &TTLCache[K, V]{
+		capacity: capacity,
+		ttl:      ttl,
+		now:      now,
+		entries:  make(map[K]*list.Element, capacity),
+		order:    list.New(),
+	}
+}
+
+// Set stores value under key, marking it as most recently used and restarting
+// its ttl.  If the cache is full the least recently used entry is evicted.
+func (c *TTLCache[K, V]) Set(key K, value V) {
+	expires := c.now().Add(c.ttl)
+	if element, ok := c.entries[key]; ok {
+		entry := element.Value.(*ttlEntry[K, V])
+		entry.value, entry.expires = value, expires
+		c.order.MoveToFront(element)
+		return
+	}
+	if c.capacity <= 0 {
+		return
+	}
+	if c.order.Len() >= c.capacity {
+		c.remove(c.order.Back())
+	}
+	c.entries[key] = c.order.PushFront(&ttlEntry[K, V]{key: key, value: value, expires: expires})
+}
+
+// Get returns the value for key if present and not expired, marking it as most
+// recently used.  Reading an entry does not extend its ttl, and an expired
+// entry is removed rather than returned.
+func (c *TTLCache[K, V]) Get(key K) (V, bool) {
+	var zero V
+	element, ok := c.entries[key]
+	if !ok {
+		return zero, false
+	}
+	entry := element.Value.(*ttlEntry[K, V])
+	if !c.now().Before(entry.expires) {
+		c.remove(element)
+		return zero, false
+	}
+	c.order.MoveToFront(element)
+	return entry.value, true
+}
+
+// Len returns the number of entries held, which may include entries that
+// have expired but not yet been accessed or evicted.
+func (c *TTLCache[K, V]) Len() int {
+	return c.order.Len()
+}
+
+func (c *TTLCache[K, V]) remove(element *list.Element) {
+	entry := c.order.Remove(element).(*ttlEntry[K, V])
+	delete(c.entries, entry.key)
+}
@@ -0,0 +1,86 @@
+package composite_types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeClock is a manually advanced clock for deterministic expiry tests.
+type fakeClock struct {
+	current time.Time
+}
+
+func (f *fakeClock) Now() time.Time {
+	return f.current
+}
+
+func (f *fakeClock) Advance(d time.Duration) {
+	f.current = f.current.Add(d)
+}
+
+func TestTTLCacheExpiry(t *testing.T) {
+	clock := &fakeClock{current: epoch}
+	c := NewTTLCache[string, int](10, time.Minute, clock.Now)
+	c.Set("foo", 1)
+
+	clock.Advance(59 * time.Second)
+	v, ok := c.Get("foo")
+	assert.True(t, ok)
+	assert.Equal(t, v, 1)
+
+	clock.Advance(time.Second)
+	_, ok = c.Get("foo")
+	assert.False(t, ok)
+	assert.Equal(t, c.Len(), 0)
+}
+
+func TestTTLCacheAccessDoesNotResurrect(t *testing.T) {
+	clock := &fakeClock{current: epoch}
+	c := NewTTLCache[string, int](10, time.Minute, clock.Now)
+	c.Set("foo", 1)
+
+	// Reading the entry does not push its expiry back.
+	clock.Advance(30 * time.Second)
+	_, ok := c.Get("foo")
+	assert.True(t, ok)
+	clock.Advance(30 * time.Second)
+	_, ok = c.Get("foo")
+	assert.False(t, ok)
+
+	// Once expired, it stays gone even if the clock were to go back.
+	clock.Advance(-time.Hour)
+	_, ok = c.Get("foo")
+	assert.False(t, ok)
+}
+
+func TestTTLCacheSetRefreshesTTL(t *testing.T) {
+	clock := &fakeClock{current: epoch}
+	c := NewTTLCache[string, int](10, time.Minute, clock.Now)
+	c.Set("foo", 1)
+	clock.Advance(45 * time.Second)
+	c.Set("foo", 2)
+	clock.Advance(45 * time.Second)
+	v, ok := c.Get("foo")
+	assert.True(t, ok)
+	assert.Equal(t, v, 2)
+}
+
+func TestTTLCacheCapacityEviction(t *testing.T) {
+	clock := &fakeClock{current: epoch}
+	c := NewTTLCache[string, int](2, time.Minute, clock.Now)
+	c.Set("a", 1)
+	c.Set("b", 2)
+	// Touch a, so b becomes the least recently used.
+	_, _ = c.Get("a")
+	c.Set("c", 3)
+
+	assert.Equal(t, c.Len(), 2)
+	_, ok := c.Get("b")
+	assert.False(t, ok)
+	_, ok = c.Get("a")
+	assert.True(t, ok)
+	_, ok = c.Get("c")
+	assert.True(t, ok)
+}
@@ -0,0 +1,13 @@
+package composite_types
+
+// ZipWith pairs up the elements of as and bs by index and combines each pair
+// with f.  When the slices differ in length the extra elements of the longer
+// one are ignored.  The output length is known up front so it is allocated
+// once with make rather than grown by append.
+func ZipWith[A, B, C any](as []A, bs []B, f func(A, B) C) []C {
+	out := make([]C, min(len(as), len(bs)))
+	for i := range out {
+		out[i] = f(as[i], bs[i])
+	}
+	return out
+}
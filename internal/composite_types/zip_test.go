@@ -0,0 +1,27 @@
+package composite_types
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestZipWithUnequalLengths(t *testing.T) {
+	add := func(a, b int) int { return a + b }
+	sums := ZipWith([]int{1, 2, 3, 4}, []int{10, 20}, add)
+	assert.Equal(t, sums, []int{11, 22})
+	assert.Len(t, sums, 2)
+	assert.Equal(t, cap(sums), 2)
+
+	sums = ZipWith([]int{1}, []int{10, 20, 30}, add)
+	assert.Equal(t, sums, []int{11})
+}
+
+func TestZipWithMixedTypes(t *testing.T) {
+	labels := ZipWith([]string{"a", "b"}, []int{1, 2}, func(s string, n int) string {
+		return s + strconv.Itoa(n)
+	})
+	assert.Equal(t, labels, []string{"a1", "b2"})
+	assert.Empty(t, ZipWith([]string{}, []int{1}, func(string, int) bool { return true }))
+}
@@ -0,0 +1,54 @@
+package concurrency
+
+import (
+	"sync"
+
+	"github.com/symonk/learning-go-book/internal/common"
+)
+
+/*
+Go's concurrency model is built around goroutines, lightweight threads managed
+by the go runtime, and channels, typed conduits used to pass values between
+goroutines.  The go proverb sums up the approach:
+
+	Don't communicate by sharing memory, share memory by communicating.
+
+Run the tests in this chapter with -race, the race detector will flag any
+unsynchronised access to shared memory.
+*/
+
+// InitConcurrency announces the concurrency chapter.
+func InitConcurrency() {
+	common.AnnounceChapter("Concurrency")
+}
+
+// Pool runs f over every job using a fixed number of worker goroutines, all
+// reading from a shared jobs channel.  Each job carries its index, so results
+// can be written straight into their slot and come back in input order even
+// though they complete in any order.  workers <= 0 defaults to a single worker.
+func Pool(jobs []int, workers int, f func(int) int) []int {
+	if workers <= 0 {
+		workers = 1
+	}
+	results := make([]int, len(jobs))
+	indexes := make(chan int)
+
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				// Every index is handled by exactly one worker, so no two
+				// goroutines ever write the same element.
+				results[i] = f(jobs[i])
+			}
+		}()
+	}
+	for i := range jobs {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+	return results
+}
@@ -0,0 +1,57 @@
+package concurrency
+
+import (
+	"math/rand"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPoolPreservesOrder(t *testing.T) {
+	jobs := make([]int, 100)
+	for i := range jobs {
+		jobs[i] = i
+	}
+	square := func(n int) int {
+		// Random latency, so jobs complete out of order.
+		time.Sleep(time.Duration(rand.Intn(100)) * time.Microsecond)
+		return n * n
+	}
+	results := Pool(jobs, 8, square)
+	for i, r := range results {
+		assert.Equal(t, r, i*i)
+	}
+}
+
+func TestPoolUsesWorkers(t *testing.T) {
+	var running, peak atomic.Int32
+	f := func(n int) int {
+		current := running.Add(1)
+		for {
+			p := peak.Load()
+			if current <= p || peak.CompareAndSwap(p, current) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+		running.Add(-1)
+		return n
+	}
+	Pool(make([]int, 50), 4, f)
+	// Never more than the requested number of workers at once.
+	assert.LessOrEqual(t, peak.Load(), int32(4))
+	assert.Greater(t, peak.Load(), int32(1))
+}
+
+func TestPoolDefaultsToOneWorker(t *testing.T) {
+	assert.Equal(t, Pool([]int{1, 2, 3}, 0, func(n int) int { return n + 1 }), []int{2, 3, 4})
+	assert.Equal(t, Pool([]int{1, 2, 3}, -5, func(n int) int { return n + 1 }), []int{2, 3, 4})
+}
+
+func TestPoolEmptyJobs(t *testing.T) {
+	results := Pool(nil, 4, func(n int) int { return n })
+	assert.NotNil(t, results)
+	assert.Empty(t, results)
+}
@@ -0,0 +1,23 @@
+package concurrency
+
+import "context"
+
+// Generate emits start, start+step, start+2*step... on the returned channel
+// until ctx is cancelled, at which point the goroutine closes the channel and
+// exits.  Selecting on ctx.Done() alongside the send is what stops the
+// goroutine leaking once the consumer loses interest, without it the send
+// would block forever.
+func Generate(ctx context.Context, start, step int) <-chan int {
+	out := make(chan int)
+	go func() {
+		defer close(out)
+		for n := start; ; n += step {
+			select {
+			case out <- n:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
@@ -0,0 +1,42 @@
+package concurrency
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerate(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ch := Generate(ctx, 10, 5)
+	assert.Equal(t, <-ch, 10)
+	assert.Equal(t, <-ch, 15)
+	assert.Equal(t, <-ch, 20)
+}
+
+func TestGenerateClosesOnCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := Generate(ctx, 0, 1)
+	for i := 0; i < 3; i++ {
+		<-ch
+	}
+	cancel()
+
+	// The goroutine may have one value in flight when it sees the
+	// cancellation, but must close the channel shortly after.
+	timeout := time.After(time.Second)
+	for {
+		select {
+		case _, ok := <-ch:
+			if !ok {
+				return
+			}
+		case <-timeout:
+			t.Fatal("channel was not closed after cancellation, goroutine leaked")
+		}
+	}
+}
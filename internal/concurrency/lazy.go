@@ -0,0 +1,26 @@
+package concurrency
+
+import "sync"
+
+// Lazy computes a value the first time it is asked for, and never again.
+// sync.Once guarantees compute runs exactly once, even when many goroutines
+// call Get at the same time, the rest block until the first call finishes.
+type Lazy[T any] struct {
+	once    sync.Once
+	compute func() T
+	value   T
+}
+
+// NewLazy returns a Lazy that will call compute on the first Get.
+func NewLazy[T any](compute func() T) *Lazy[T] {
+	return &Lazy[T]{compute: compute}
+}
+
+// Get returns the value, computing it on the first call.  It is safe for
+// concurrent use.
+func (l *Lazy[T]) Get() T {
+	l.once.Do(func() {
+		l.value = l.compute()
+	})
+	return l.value
+}
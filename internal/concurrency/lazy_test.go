@@ -0,0 +1,35 @@
+package concurrency
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLazyComputesOnce(t *testing.T) {
+	var calls atomic.Int32
+	lazy := NewLazy(func() string {
+		calls.Add(1)
+		return "expensive"
+	})
+	// Nothing is computed until it is asked for.
+	assert.Zero(t, calls.Load())
+
+	var wg sync.WaitGroup
+	results := make([]string, 100)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i] = lazy.Get()
+		}(i)
+	}
+	wg.Wait()
+
+	assert.Equal(t, calls.Load(), int32(1))
+	for _, r := range results {
+		assert.Equal(t, r, "expensive")
+	}
+}
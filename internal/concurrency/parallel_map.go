@@ -0,0 +1,50 @@
+package concurrency
+
+import (
+	"context"
+	"sync"
+)
+
+// ParallelMap applies f to every element of in, each on its own goroutine, and
+// returns the results in input order.  The output slice is sized up front and
+// each goroutine writes only to its own index, so no locking is required, a
+// sync.WaitGroup is enough to know when every goroutine has finished.
+// f must be safe for concurrent use.  One goroutine per element is fine for
+// modest inputs, see BoundedParallelMap for capping how many run at once.
+func ParallelMap[T, U any](in []T, f func(T) U) []U {
+	out := make([]U, len(in))
+	var wg sync.WaitGroup
+	wg.Add(len(in))
+	for i, v := range in {
+		go func(i int, v T) {
+			defer wg.Done()
+			out[i] = f(v)
+		}(i, v)
+	}
+	wg.Wait()
+	return out
+}
+
+// BoundedParallelMap is ParallelMap with at most limit calls of f running at
+// once, useful when f hits a resource that cannot take unlimited load.  The
+// loop acquires a Semaphore slot before starting each goroutine, and the
+// goroutine releases it when f returns, so the loop itself waits while limit
+// goroutines are busy.  A limit below 1 panics, as it does for NewSemaphore.
+func BoundedParallelMap[T, U any](in []T, limit int, f func(T) U) []U {
+	sem := NewSemaphore(limit)
+	out := make([]U, len(in))
+	var wg sync.WaitGroup
+	wg.Add(len(in))
+	for i, v := range in {
+		// The background context is never cancelled, so Acquire only returns
+		// once a slot is free and cannot fail.
+		_ = sem.Acquire(context.Background())
+		go func(i int, v T) {
+			defer wg.Done()
+			defer sem.Release()
+			out[i] = f(v)
+		}(i, v)
+	}
+	wg.Wait()
+	return out
+}
@@ -0,0 +1,65 @@
+package concurrency
+
+import (
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParallelMapPreservesOrder(t *testing.T) {
+	in := make([]int, 200)
+	for i := range in {
+		in[i] = i
+	}
+	out := ParallelMap(in, func(n int) string {
+		// Later elements finish first.
+		time.Sleep(time.Duration(len(in)-n) * time.Microsecond)
+		return strconv.Itoa(n)
+	})
+	assert.Len(t, out, len(in))
+	for i, s := range out {
+		assert.Equal(t, s, strconv.Itoa(i))
+	}
+}
+
+func TestParallelMapEmpty(t *testing.T) {
+	out := ParallelMap([]int{}, func(n int) int { return n })
+	assert.NotNil(t, out)
+	assert.Empty(t, out)
+}
+
+func TestBoundedParallelMapLimitsConcurrency(t *testing.T) {
+	const limit = 3
+	var running, peak atomic.Int32
+	in := make([]int, 50)
+	for i := range in {
+		in[i] = i
+	}
+	out := BoundedParallelMap(in, limit, func(n int) int {
+		now := running.Add(1)
+		for {
+			old := peak.Load()
+			if now <= old || peak.CompareAndSwap(old, now) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+		running.Add(-1)
+		return n * 2
+	})
+	for i, v := range out {
+		assert.Equal(t, v, i*2)
+	}
+	assert.LessOrEqual(t, peak.Load(), int32(limit))
+	assert.Greater(t, peak.Load(), int32(1))
+}
+
+func TestBoundedParallelMapInvalidLimit(t *testing.T) {
+	assert.Panics(t, func() { BoundedParallelMap([]int{1}, 0, func(n int) int { return n }) })
+	out := BoundedParallelMap([]int{}, 1, func(n int) int { return n })
+	assert.NotNil(t, out)
+	assert.Empty(t, out)
+}
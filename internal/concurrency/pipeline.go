@@ -0,0 +1,40 @@
+package concurrency
+
+import "context"
+
+// Pipeline chains stages together, each running in its own goroutine and
+// connected to the next by an unbuffered channel.  Values from in flow through
+// every stage in order, so Pipeline(ctx, in, double, increment) yields 2n+1.
+// Each stage closes its output when its input is drained, so closing in shuts
+// the whole pipeline down in order.  Cancelling ctx stops every stage early,
+// even if nothing is reading the output, so no goroutine is left blocked.
+func Pipeline(ctx context.Context, in <-chan int, stages ...func(int) int) <-chan int {
+	out := in
+	for _, stage := range stages {
+		out = runStage(ctx, out, stage)
+	}
+	return out
+}
+
+func runStage(ctx context.Context, in <-chan int, f func(int) int) <-chan int {
+	out := make(chan int)
+	go func() {
+		defer close(out)
+		for {
+			select {
+			case v, ok := <-in:
+				if !ok {
+					return
+				}
+				select {
+				case out <- f(v):
+				case <-ctx.Done():
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
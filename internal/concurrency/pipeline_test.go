@@ -0,0 +1,57 @@
+package concurrency
+
+import (
+	"context"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func double(n int) int    { return n * 2 }
+func increment(n int) int { return n + 1 }
+
+func TestPipelineComposesStages(t *testing.T) {
+	in := make(chan int)
+	go func() {
+		defer close(in)
+		for i := 1; i <= 5; i++ {
+			in <- i
+		}
+	}()
+
+	var results []int
+	for v := range Pipeline(context.Background(), in, double, increment) {
+		results = append(results, v)
+	}
+	assert.Equal(t, results, []int{3, 5, 7, 9, 11})
+}
+
+func TestPipelineNoStages(t *testing.T) {
+	in := make(chan int, 1)
+	in <- 1
+	close(in)
+	out := Pipeline(context.Background(), in)
+	assert.Equal(t, <-out, 1)
+}
+
+func TestPipelineCancellationDoesNotLeak(t *testing.T) {
+	before := runtime.NumGoroutine()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	// An input that never closes, and an output only read from once.
+	out := Pipeline(ctx, Generate(ctx, 0, 1), double, increment, double)
+	assert.Equal(t, <-out, 2)
+	cancel()
+
+	// The stages (and the generator) all exit once cancelled.  This is polled
+	// by hand, assert.Eventually runs its condition on another goroutine.
+	deadline := time.Now().Add(time.Second)
+	for runtime.NumGoroutine() > before {
+		if time.Now().After(deadline) {
+			t.Fatalf("goroutines leaked: %d running, %d before", runtime.NumGoroutine(), before)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
@@ -0,0 +1,45 @@
+package concurrency
+
+import "sync"
+
+// SafeMap is a map guarded by a sync.RWMutex, making it safe for concurrent
+// use.  Any number of readers can hold the read lock at once, but a writer
+// needs the lock exclusively.  Builtin maps are NOT safe for concurrent
+// writes, the runtime will often crash with "concurrent map writes".
+// The zero value is ready to use.
+type SafeMap[K comparable, V any] struct {
+	mu sync.RWMutex
+	m  map[K]V
+}
+
+// Store sets the value for key.
+func (s *SafeMap[K, V]) Store(key K, value V) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.m == nil {
+		s.m = make(map[K]V)
+	}
+	s.m[key] = value
+}
+
+// Load returns the value for key, with the comma ok idiom for presence.
+func (s *SafeMap[K, V]) Load(key K) (V, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	v, ok := s.m[key]
+	return v, ok
+}
+
+// Delete removes key.
+func (s *SafeMap[K, V]) Delete(key K) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.m, key)
+}
+
+// Len returns the number of keys.
+func (s *SafeMap[K, V]) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.m)
+}
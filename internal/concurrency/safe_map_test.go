@@ -0,0 +1,68 @@
+package concurrency
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSafeMapConcurrentAccess(t *testing.T) {
+	var m SafeMap[int, int]
+	var wg sync.WaitGroup
+
+	// 10 writers store 0..999 between them.
+	for w := 0; w < 10; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := w * 100; i < (w+1)*100; i++ {
+				m.Store(i, i*i)
+			}
+		}(w)
+	}
+	// Readers hammer the map at the same time.
+	for r := 0; r < 10; r++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				_, _ = m.Load(i)
+				_ = m.Len()
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, m.Len(), 1000)
+
+	// Concurrently delete every odd key.
+	for w := 0; w < 10; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := w * 100; i < (w+1)*100; i++ {
+				if i%2 == 1 {
+					m.Delete(i)
+				}
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	assert.Equal(t, m.Len(), 500)
+	for i := 0; i < 1000; i++ {
+		v, ok := m.Load(i)
+		assert.Equal(t, ok, i%2 == 0)
+		if ok {
+			assert.Equal(t, v, i*i)
+		}
+	}
+}
+
+func TestSafeMapZeroValue(t *testing.T) {
+	var m SafeMap[string, int]
+	_, ok := m.Load("missing")
+	assert.False(t, ok)
+	m.Delete("missing")
+	assert.Zero(t, m.Len())
+}
@@ -0,0 +1,22 @@
+package concurrency
+
+import "github.com/symonk/learning-go-book/internal/common"
+
+// Semaphore limits how many goroutines can be inside a section of code at
+// once.  It is the common.Semaphore, re-exported here so the chapter can use
+// it without a second implementation.  A buffered channel is the counter: its
+// capacity is the number of slots, sending into it takes a slot and receiving
+// from it gives one back.  When the buffer is full, further sends block until
+// somebody releases.
+//
+// Acquire takes a slot, blocking until one is available.  If ctx is done first,
+// ctx.Err() is returned and no slot is taken, so a waiter can never be stuck
+// forever (as long as its context has a deadline or is cancelled).  Release
+// gives a slot back, releasing more than was acquired panics rather than
+// blocking.
+type Semaphore = common.Semaphore
+
+// NewSemaphore returns a Semaphore with n slots, n below 1 panics.
+func NewSemaphore(n int) *Semaphore {
+	return common.New(n)
+}
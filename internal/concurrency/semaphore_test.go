@@ -0,0 +1,65 @@
+package concurrency
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSemaphoreBlocksUntilRelease(t *testing.T) {
+	s := NewSemaphore(2)
+	ctx := context.Background()
+	assert.NoError(t, s.Acquire(ctx))
+	assert.NoError(t, s.Acquire(ctx))
+
+	acquired := make(chan error)
+	go func() {
+		acquired <- s.Acquire(ctx)
+	}()
+
+	// The semaphore is full, the third acquire must wait.
+	select {
+	case <-acquired:
+		t.Fatal("acquired a slot from a full semaphore")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	s.Release()
+	select {
+	case err := <-acquired:
+		assert.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("blocked acquire did not proceed after release")
+	}
+}
+
+func TestSemaphoreAcquireRespectsContext(t *testing.T) {
+	s := NewSemaphore(1)
+	assert.NoError(t, s.Acquire(context.Background()))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		cancel()
+	}()
+	assert.ErrorIs(t, s.Acquire(ctx), context.Canceled)
+
+	// The failed acquire didn't take a slot, after one release there is room.
+	s.Release()
+	assert.NoError(t, s.Acquire(context.Background()))
+}
+
+func TestSemaphoreOverReleasePanics(t *testing.T) {
+	s := NewSemaphore(1)
+	assert.Panics(t, s.Release)
+
+	assert.NoError(t, s.Acquire(context.Background()))
+	assert.NotPanics(t, s.Release)
+	assert.Panics(t, s.Release)
+}
+
+func TestSemaphoreInvalidSizePanics(t *testing.T) {
+	assert.Panics(t, func() { NewSemaphore(0) })
+}
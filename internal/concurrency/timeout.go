@@ -0,0 +1,17 @@
+package concurrency
+
+import "time"
+
+// RecvTimeout receives a value from ch, giving up after d.  select waits on
+// whichever case is ready first, time.After returns a channel that delivers
+// once d has elapsed.  On timeout the zero value and false are returned.
+// A closed ch also returns the zero value and false.
+func RecvTimeout[T any](ch <-chan T, d time.Duration) (T, bool) {
+	select {
+	case v, ok := <-ch:
+		return v, ok
+	case <-time.After(d):
+		var zero T
+		return zero, false
+	}
+}
@@ -0,0 +1,35 @@
+package concurrency
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecvTimeoutValueArrives(t *testing.T) {
+	ch := make(chan string)
+	go func() {
+		time.Sleep(5 * time.Millisecond)
+		ch <- "hello"
+	}()
+	v, ok := RecvTimeout(ch, time.Second)
+	assert.True(t, ok)
+	assert.Equal(t, v, "hello")
+}
+
+func TestRecvTimeoutFires(t *testing.T) {
+	ch := make(chan int)
+	start := time.Now()
+	v, ok := RecvTimeout(ch, 20*time.Millisecond)
+	assert.False(t, ok)
+	assert.Zero(t, v)
+	assert.GreaterOrEqual(t, time.Since(start), 20*time.Millisecond)
+}
+
+func TestRecvTimeoutClosedChannel(t *testing.T) {
+	ch := make(chan int)
+	close(ch)
+	_, ok := RecvTimeout(ch, time.Second)
+	assert.False(t, ok)
+}
@@ -0,0 +1,51 @@
+package contextpkg
+
+import (
+	"context"
+	"time"
+
+	"github.com/symonk/learning-go-book/internal/common"
+)
+
+/*
+The context package carries deadlines, cancellation signals and request scoped
+values across API boundaries.  By convention a context.Context is the first
+argument of a function and is named ctx.  Contexts are immutable, deriving a
+child (WithCancel, WithTimeout, WithValue) wraps the parent rather than
+modifying it, and cancelling a parent cancels all of its children.
+*/
+
+// InitContext announces the context chapter.
+func InitContext() {
+	common.AnnounceChapter("Context")
+}
+
+// requestIDKey is unexported so no other package can construct it.  Context
+// values are looked up by comparing keys, using a plain string such as
+// "request_id" risks colliding with an unrelated package using the same one.
+type requestIDKey struct{}
+
+// WithRequestID returns a child of ctx carrying id.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// RequestID returns the request id stored on ctx (or any of its parents) and
+// whether one was found.
+func RequestID(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(requestIDKey{}).(string)
+	return id, ok
+}
+
+// DoWork simulates work that takes d to complete.  If ctx is cancelled or its
+// deadline passes first, the work is abandoned and ctx.Err() is returned.
+func DoWork(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
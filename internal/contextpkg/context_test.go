@@ -0,0 +1,49 @@
+package contextpkg
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestIDSurvivesChildren(t *testing.T) {
+	ctx := WithRequestID(context.Background(), "abc-123")
+	ctx, cancel := context.WithTimeout(ctx, time.Minute)
+	defer cancel()
+	ctx, cancel = context.WithCancel(ctx)
+	defer cancel()
+
+	id, ok := RequestID(ctx)
+	assert.True(t, ok)
+	assert.Equal(t, id, "abc-123")
+}
+
+func TestRequestIDMissing(t *testing.T) {
+	// A plain string key with the same name does not collide with ours.
+	ctx := context.WithValue(context.Background(), "request_id", "nope")
+	_, ok := RequestID(ctx)
+	assert.False(t, ok)
+}
+
+func TestDoWorkCompletes(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	assert.NoError(t, DoWork(ctx, 10*time.Millisecond))
+}
+
+func TestDoWorkDeadlineExceeded(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	err := DoWork(ctx, time.Second)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), 500*time.Millisecond)
+}
+
+func TestDoWorkCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.ErrorIs(t, DoWork(ctx, time.Second), context.Canceled)
+}
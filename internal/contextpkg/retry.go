@@ -0,0 +1,42 @@
+package contextpkg
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrInvalidAttempts is returned by Retry when asked for fewer than one attempt.
+var ErrInvalidAttempts = errors.New("attempts must be at least 1")
+
+// Retry calls fn up to attempts times, sleeping for backoff between failed
+// attempts.  The wait happens in a select alongside ctx.Done(), so cancelling
+// ctx aborts the retry loop immediately and ctx.Err() is returned.  If every
+// attempt fails the error from the final attempt is returned.  With attempts
+// below 1 fn would never run, rather than report success for work that never
+// happened ErrInvalidAttempts is returned.
+func Retry(ctx context.Context, attempts int, backoff time.Duration, fn func() error) error {
+	if attempts < 1 {
+		return fmt.Errorf("%w: got %d", ErrInvalidAttempts, attempts)
+	}
+	var err error
+	for attempt := 0; attempt < attempts; attempt++ {
+		if attempt > 0 {
+			timer := time.NewTimer(backoff)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return ctx.Err()
+			case <-timer.C:
+			}
+		}
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+		if err = fn(); err == nil {
+			return nil
+		}
+	}
+	return err
+}
@@ -0,0 +1,70 @@
+package contextpkg
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var errFlaky = errors.New("flaky")
+
+func TestRetrySucceedsOnSecondAttempt(t *testing.T) {
+	calls := 0
+	err := Retry(context.Background(), 3, time.Millisecond, func() error {
+		calls++
+		if calls < 2 {
+			return errFlaky
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, calls, 2)
+}
+
+func TestRetryExhausted(t *testing.T) {
+	calls := 0
+	err := Retry(context.Background(), 3, time.Millisecond, func() error {
+		calls++
+		return errFlaky
+	})
+	assert.ErrorIs(t, err, errFlaky)
+	assert.Equal(t, calls, 3)
+}
+
+func TestRetryCancelledMidRetry(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	calls := 0
+	start := time.Now()
+	err := Retry(ctx, 5, time.Second, func() error {
+		calls++
+		// Cancel during the first attempt, the backoff should be cut short.
+		cancel()
+		return errFlaky
+	})
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, calls, 1)
+	assert.Less(t, time.Since(start), 500*time.Millisecond)
+}
+
+func TestRetryAlreadyCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := Retry(ctx, 3, time.Millisecond, func() error {
+		t.Fatal("fn should not be called")
+		return nil
+	})
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestRetryInvalidAttempts(t *testing.T) {
+	for _, attempts := range []int{0, -1} {
+		err := Retry(context.Background(), attempts, time.Millisecond, func() error {
+			t.Fatal("fn should not be called")
+			return nil
+		})
+		assert.ErrorIs(t, err, ErrInvalidAttempts)
+	}
+}
@@ -0,0 +1,46 @@
+package controlflow
+
+import "github.com/symonk/learning-go-book/internal/common"
+
+/*
+Go keeps control flow small.  There is a single looping keyword, for, which
+covers C style loops, while loops, infinite loops and ranging over
+collections.  if and switch can declare variables scoped to the statement,
+switch cases do not fall through by default, and labels let break and continue
+target an outer loop.
+*/
+
+// InitControlFlow announces the control flow chapter.
+func InitControlFlow() {
+	common.AnnounceChapter("Control Flow")
+}
+
+// FindInGrid returns the position of the first occurrence of target in g,
+// scanning row by row.  A plain break only exits the innermost loop, breaking
+// to the outer label stops both loops the moment target is found, without
+// needing a found flag checked on every row.  Rows may be of differing
+// lengths.
+func FindInGrid(g [][]int, target int) (row, col int, found bool) {
+outer:
+	for r, cells := range g {
+		for c, v := range cells {
+			if v == target {
+				row, col, found = r, c, true
+				break outer
+			}
+		}
+	}
+	return row, col, found
+}
+
+// RangeSum returns the sum of 0 through n-1.  Since go 1.22 range accepts an
+// integer, `for i := range n` counts from 0 up to (but not including) n, a
+// shorter form of `for i := 0; i < n; i++`.  It needs the go directive in
+// go.mod to be at least 1.22.  A zero or negative n runs no iterations.
+func RangeSum(n int) int {
+	sum := 0
+	for i := range n {
+		sum += i
+	}
+	return sum
+}
@@ -0,0 +1,57 @@
+package controlflow
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var grid = [][]int{
+	{1, 2, 3},
+	{4, 5, 6},
+	{7, 8, 9},
+}
+
+func TestFindInGridInterior(t *testing.T) {
+	row, col, found := FindInGrid(grid, 5)
+	assert.True(t, found)
+	assert.Equal(t, row, 1)
+	assert.Equal(t, col, 1)
+}
+
+func TestFindInGridCorner(t *testing.T) {
+	row, col, found := FindInGrid(grid, 9)
+	assert.True(t, found)
+	assert.Equal(t, row, 2)
+	assert.Equal(t, col, 2)
+
+	row, col, found = FindInGrid(grid, 1)
+	assert.True(t, found)
+	assert.Equal(t, row, 0)
+	assert.Equal(t, col, 0)
+}
+
+func TestFindInGridFirstMatch(t *testing.T) {
+	// The search stops at the first match.
+	row, col, found := FindInGrid([][]int{{0, 7}, {7}}, 7)
+	assert.True(t, found)
+	assert.Equal(t, row, 0)
+	assert.Equal(t, col, 1)
+}
+
+func TestFindInGridNotFound(t *testing.T) {
+	row, col, found := FindInGrid(grid, 42)
+	assert.False(t, found)
+	assert.Equal(t, row, 0)
+	assert.Equal(t, col, 0)
+
+	_, _, found = FindInGrid(nil, 1)
+	assert.False(t, found)
+}
+
+func TestRangeSum(t *testing.T) {
+	assert.Equal(t, RangeSum(5), 10)
+	assert.Equal(t, RangeSum(0), 0)
+	assert.Equal(t, RangeSum(-3), 0)
+	assert.Equal(t, RangeSum(100), 4950)
+}
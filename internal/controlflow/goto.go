@@ -0,0 +1,77 @@
+package controlflow
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+var (
+	// ErrEmptyNumber is returned when there are no digits to parse.
+	ErrEmptyNumber = errors.New("no digits")
+	// ErrUnexpectedChar is returned when the input contains something other
+	// than an optional leading sign followed by digits.
+	ErrUnexpectedChar = errors.New("unexpected character")
+	// ErrNumberTooLarge is returned when the number does not fit in an int.
+	ErrNumberTooLarge = errors.New("number too large")
+)
+
+// ParseNumberState parses a base 10 integer with an optional leading + or -.
+// The digits are consumed by a small state machine, the reading loop and its
+// two exits are labels and goto moves between them.
+//
+// goto is rarely the right tool.  Loops, switch and early returns express
+// almost all control flow more clearly, and go restricts goto so it cannot
+// jump over variable declarations or into a block.  Where it can earn its
+// place is in small, hand written state machines like this one (lexers,
+// parsers), where each state maps directly to a label and the jumps read
+// like the transitions in the state diagram.  Outside of that, prefer the
+// structured alternatives.
+func ParseNumberState(s string) (int, error) {
+	var (
+		i        int
+		negative bool
+		n        uint64
+		d        uint64
+		limit    uint64 = math.MaxInt
+	)
+
+	// An optional sign may lead the number, checked once before the digits.
+	if i < len(s) && (s[i] == '+' || s[i] == '-') {
+		negative = s[i] == '-'
+		i++
+	}
+	if negative {
+		// The negative range reaches one further, math.MinInt has no positive counterpart.
+		limit++
+	}
+	if i == len(s) {
+		return 0, fmt.Errorf("%w: %q", ErrEmptyNumber, s)
+	}
+
+digits:
+	if i == len(s) {
+		goto done
+	}
+	if s[i] < '0' || s[i] > '9' {
+		goto invalid
+	}
+	d = uint64(s[i] - '0')
+	// Check before multiplying, n*10 + d could overflow uint64 and wrap around
+	// to a small number which would slip under limit.
+	if n > (limit-d)/10 {
+		return 0, fmt.Errorf("%w: %q", ErrNumberTooLarge, s)
+	}
+	n = n*10 + d
+	i++
+	goto digits
+
+invalid:
+	return 0, fmt.Errorf("%w: %q at position %d in %q", ErrUnexpectedChar, s[i], i, s)
+
+done:
+	if negative {
+		return int(-n), nil
+	}
+	return int(n), nil
+}
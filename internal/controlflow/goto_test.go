@@ -0,0 +1,64 @@
+package controlflow
+
+import (
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseNumberState(t *testing.T) {
+	n, err := ParseNumberState("-42")
+	assert.NoError(t, err)
+	assert.Equal(t, n, -42)
+
+	n, err = ParseNumberState("+7")
+	assert.NoError(t, err)
+	assert.Equal(t, n, 7)
+
+	n, err = ParseNumberState("0")
+	assert.NoError(t, err)
+	assert.Equal(t, n, 0)
+}
+
+func TestParseNumberStateLimits(t *testing.T) {
+	n, err := ParseNumberState(strconv.Itoa(math.MaxInt))
+	assert.NoError(t, err)
+	assert.Equal(t, n, math.MaxInt)
+
+	n, err = ParseNumberState(strconv.Itoa(math.MinInt))
+	assert.NoError(t, err)
+	assert.Equal(t, n, math.MinInt)
+
+	_, err = ParseNumberState("99999999999999999999")
+	assert.ErrorIs(t, err, ErrNumberTooLarge)
+
+	// n*10 would wrap uint64 to a value below the limit.
+	_, err = ParseNumberState("20000000000000000000")
+	assert.ErrorIs(t, err, ErrNumberTooLarge)
+
+	// One past either end of the int range.
+	_, err = ParseNumberState("9223372036854775808")
+	assert.ErrorIs(t, err, ErrNumberTooLarge)
+	_, err = ParseNumberState("-9223372036854775809")
+	assert.ErrorIs(t, err, ErrNumberTooLarge)
+}
+
+func TestParseNumberStateTrailingGarbage(t *testing.T) {
+	_, err := ParseNumberState("12x")
+	assert.ErrorIs(t, err, ErrUnexpectedChar)
+	assert.EqualError(t, err, `unexpected character: 'x' at position 2 in "12x"`)
+
+	_, err = ParseNumberState("--1")
+	assert.ErrorIs(t, err, ErrUnexpectedChar)
+}
+
+func TestParseNumberStateEmpty(t *testing.T) {
+	_, err := ParseNumberState("")
+	assert.ErrorIs(t, err, ErrEmptyNumber)
+	assert.EqualError(t, err, `no digits: ""`)
+
+	_, err = ParseNumberState("-")
+	assert.ErrorIs(t, err, ErrEmptyNumber)
+}
@@ -0,0 +1,41 @@
+package controlflow
+
+import "strconv"
+
+// Grade converts a score into a letter grade.  A switch with no tag evaluates
+// each case as a boolean expression from top to bottom, running the first one
+// which is true.  Unlike C, go does not fall through into the next case once
+// one matches, so each case only has to check its lower bound, the earlier
+// cases have already ruled out higher scores.  Falling through has to be
+// asked for explicitly with the fallthrough keyword.
+func Grade(score int) string {
+	switch {
+	case score >= 90:
+		return "A"
+	case score >= 80:
+		return "B"
+	case score >= 70:
+		return "C"
+	case score >= 60:
+		return "D"
+	default:
+		return "F"
+	}
+}
+
+// FizzBuzz returns "Fizz" for multiples of 3, "Buzz" for multiples of 5,
+// "FizzBuzz" for multiples of both and otherwise n itself.  The order of the
+// cases matters, the combined check must come first as the first true case
+// wins.
+func FizzBuzz(n int) string {
+	switch {
+	case n%15 == 0:
+		return "FizzBuzz"
+	case n%3 == 0:
+		return "Fizz"
+	case n%5 == 0:
+		return "Buzz"
+	default:
+		return strconv.Itoa(n)
+	}
+}
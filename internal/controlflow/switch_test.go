@@ -0,0 +1,41 @@
+package controlflow
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGradeBoundaries(t *testing.T) {
+	assert.Equal(t, Grade(100), "A")
+	assert.Equal(t, Grade(90), "A")
+	assert.Equal(t, Grade(89), "B")
+	assert.Equal(t, Grade(80), "B")
+	assert.Equal(t, Grade(70), "C")
+	assert.Equal(t, Grade(60), "D")
+	assert.Equal(t, Grade(59), "F")
+	assert.Equal(t, Grade(0), "F")
+}
+
+func TestFizzBuzz(t *testing.T) {
+	assert.Equal(t, FizzBuzz(3), "Fizz")
+	assert.Equal(t, FizzBuzz(5), "Buzz")
+	assert.Equal(t, FizzBuzz(15), "FizzBuzz")
+	assert.Equal(t, FizzBuzz(7), "7")
+	assert.Equal(t, FizzBuzz(30), "FizzBuzz")
+}
+
+func TestFallthrough(t *testing.T) {
+	// fallthrough runs the next case body without checking its condition.
+	var reached []string
+	switch n := 5; {
+	case n > 3:
+		reached = append(reached, "greater than 3")
+		fallthrough
+	case n > 100:
+		reached = append(reached, "greater than 100?")
+	case n > 1:
+		reached = append(reached, "greater than 1")
+	}
+	assert.Equal(t, reached, []string{"greater than 3", "greater than 100?"})
+}
@@ -0,0 +1,25 @@
+package errors
+
+import "errors"
+
+// Collector gathers errors so that every problem can be reported at once,
+// rather than stopping at the first, which is handy when validating many
+// fields of a form or request.  The zero value is ready to use.
+type Collector struct {
+	errs []error
+}
+
+// Add records err, nil errors are ignored so the result of a check can be
+// passed straight in.
+func (c *Collector) Add(err error) {
+	if err != nil {
+		c.errs = append(c.errs, err)
+	}
+}
+
+// Err returns every collected error joined together with errors.Join, or nil
+// if there were none.  errors.Is and errors.As can find any of the joined
+// errors in the result.
+func (c *Collector) Err() error {
+	return errors.Join(c.errs...)
+}
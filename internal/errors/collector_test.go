@@ -0,0 +1,38 @@
+package errors
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	errNameMissing = errors.New("name missing")
+	errAgeInvalid  = errors.New("age invalid")
+)
+
+func TestCollectorOnlyNil(t *testing.T) {
+	var c Collector
+	c.Add(nil)
+	c.Add(nil)
+	assert.NoError(t, c.Err())
+	assert.Nil(t, c.Err())
+}
+
+func TestCollectorJoinsErrors(t *testing.T) {
+	var c Collector
+	c.Add(errNameMissing)
+	c.Add(nil)
+	c.Add(Lookup(100))
+	c.Add(errAgeInvalid)
+
+	err := c.Err()
+	assert.Error(t, err)
+	assert.True(t, errors.Is(err, errNameMissing))
+	assert.True(t, errors.Is(err, errAgeInvalid))
+	// Wrapped sentinels are found through the join too.
+	assert.True(t, errors.Is(err, ErrNotFound))
+	// Joined errors are separated by newlines.
+	assert.EqualError(t, err, "name missing\nlookup user 100: not found\nage invalid")
+}
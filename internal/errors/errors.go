@@ -0,0 +1,61 @@
+package errors
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/symonk/learning-go-book/internal/common"
+)
+
+/*
+Errors in go are values, any type implementing `Error() string` satisfies the
+builtin error interface.  Functions return an error as their last return value
+and callers check it explicitly, there are no exceptions.
+
+Errors can wrap other errors using fmt.Errorf and the %w verb, adding context
+while preserving the original.  errors.Is walks the chain looking for a given
+(sentinel) value and errors.As walks it looking for a given type.
+*/
+
+// InitErrors announces the errors chapter.
+func InitErrors() {
+	common.AnnounceChapter("Errors")
+}
+
+// ErrNotFound is a sentinel error, a package level value callers can compare
+// against with errors.Is.
+var ErrNotFound = errors.New("not found")
+
+var users = map[int]string{1: "alice", 2: "bob"}
+
+// Lookup returns nil if a user with the given id exists, otherwise it wraps
+// ErrNotFound with the id that was missing.
+func Lookup(id int) error {
+	if _, ok := users[id]; !ok {
+		return fmt.Errorf("lookup user %d: %w", id, ErrNotFound)
+	}
+	return nil
+}
+
+// ValidationError is a custom error type, carrying structured detail about
+// what failed that callers can extract with errors.As.
+type ValidationError struct {
+	Field  string
+	Reason string
+}
+
+func (v *ValidationError) Error() string {
+	return fmt.Sprintf("invalid %s: %s", v.Field, v.Reason)
+}
+
+// Validate checks a name and age, returning a wrapped *ValidationError for
+// the first problem found.
+func Validate(name string, age int) error {
+	if name == "" {
+		return fmt.Errorf("validate: %w", &ValidationError{Field: "name", Reason: "must not be empty"})
+	}
+	if age < 0 {
+		return fmt.Errorf("validate: %w", &ValidationError{Field: "age", Reason: "must not be negative"})
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+package errors
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLookupFound(t *testing.T) {
+	assert.NoError(t, Lookup(1))
+}
+
+func TestLookupWrapsSentinel(t *testing.T) {
+	err := Lookup(100)
+	assert.EqualError(t, err, "lookup user 100: not found")
+	// The error is not ErrNotFound itself, it wraps it.
+	assert.False(t, err == ErrNotFound)
+	assert.True(t, errors.Is(err, ErrNotFound))
+	assert.Equal(t, errors.Unwrap(err), ErrNotFound)
+}
+
+func TestValidateErrorsAs(t *testing.T) {
+	err := Validate("", 10)
+	var validationErr *ValidationError
+	assert.True(t, errors.As(err, &validationErr))
+	assert.Equal(t, validationErr.Field, "name")
+	assert.EqualError(t, err, "validate: invalid name: must not be empty")
+
+	err = Validate("alice", -1)
+	assert.True(t, errors.As(err, &validationErr))
+	assert.Equal(t, validationErr.Field, "age")
+}
+
+func TestValidateOk(t *testing.T) {
+	assert.NoError(t, Validate("alice", 30))
+}
@@ -0,0 +1,24 @@
+package errors
+
+import "fmt"
+
+// SafeCall runs fn, converting any panic into a returned error so that a
+// single misbehaving call can't take down the whole program.  This is the
+// usual shape of a recover boundary, at the top of a goroutine or request
+// handler.  If fn panics with an error, that error is returned as is (so
+// errors.Is still matches it), any other value is formatted into a new error.
+func SafeCall(fn func()) (err error) {
+	defer func() {
+		r := recover()
+		if r == nil {
+			return
+		}
+		if e, ok := r.(error); ok {
+			err = e
+			return
+		}
+		err = fmt.Errorf("panic: %v", r)
+	}()
+	fn()
+	return nil
+}
@@ -0,0 +1,36 @@
+package errors
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSafeCallNoPanic(t *testing.T) {
+	called := false
+	err := SafeCall(func() { called = true })
+	assert.NoError(t, err)
+	assert.True(t, called)
+}
+
+func TestSafeCallStringPanic(t *testing.T) {
+	err := SafeCall(func() { panic("something went wrong") })
+	assert.EqualError(t, err, "panic: something went wrong")
+}
+
+func TestSafeCallErrorPanic(t *testing.T) {
+	err := SafeCall(func() { panic(ErrNotFound) })
+	// Returned directly, not double wrapped.
+	assert.Equal(t, err, ErrNotFound)
+	assert.True(t, errors.Is(err, ErrNotFound))
+}
+
+func TestSafeCallRuntimePanic(t *testing.T) {
+	// Runtime panics, such as indexing out of range, are runtime.Error values.
+	err := SafeCall(func() {
+		var s []int
+		_ = s[1]
+	})
+	assert.ErrorContains(t, err, "index out of range")
+}
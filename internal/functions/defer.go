@@ -0,0 +1,33 @@
+package functions
+
+import (
+	"fmt"
+	"io"
+)
+
+// DeferOrder shows that deferred calls run when the surrounding function
+// returns, in last in first out order.  The non deferred line is written
+// first even though it appears last in the source.  Note: the arguments to a
+// deferred call are evaluated immediately, only the call itself is delayed.
+func DeferOrder(w io.Writer) {
+	defer fmt.Fprintln(w, "deferred 1")
+	defer fmt.Fprintln(w, "deferred 2")
+	defer fmt.Fprintln(w, "deferred 3")
+	fmt.Fprintln(w, "not deferred")
+}
+
+// ResourceCleanup acquires a (pretend) resource and runs work with it.  The
+// release is deferred, so it is guaranteed to run even if work panics.  The
+// panic itself is recovered by an earlier deferred function, which runs after
+// the release as defers unwind in reverse order.
+func ResourceCleanup(w io.Writer, work func()) {
+	defer func() {
+		if r := recover(); r != nil {
+			fmt.Fprintln(w, "recovered:", r)
+		}
+	}()
+	fmt.Fprintln(w, "acquired resource")
+	defer fmt.Fprintln(w, "released resource")
+	work()
+	fmt.Fprintln(w, "work complete")
+}
@@ -0,0 +1,30 @@
+package functions
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeferOrder(t *testing.T) {
+	var buffer bytes.Buffer
+	DeferOrder(&buffer)
+	assert.Equal(t, buffer.String(), "not deferred\ndeferred 3\ndeferred 2\ndeferred 1\n")
+}
+
+func TestResourceCleanupNoPanic(t *testing.T) {
+	var buffer bytes.Buffer
+	ResourceCleanup(&buffer, func() { fmt.Fprintln(&buffer, "working") })
+	assert.Equal(t, buffer.String(), "acquired resource\nworking\nwork complete\nreleased resource\n")
+}
+
+func TestResourceCleanupPanic(t *testing.T) {
+	var buffer bytes.Buffer
+	assert.NotPanics(t, func() {
+		ResourceCleanup(&buffer, func() { panic("boom") })
+	})
+	// "work complete" is never reached, but the release still happens.
+	assert.Equal(t, buffer.String(), "acquired resource\nreleased resource\nrecovered: boom\n")
+}
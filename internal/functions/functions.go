@@ -0,0 +1,54 @@
+package functions
+
+import "github.com/symonk/learning-go-book/internal/common"
+
+/*
+Functions in go are first class values, they can be assigned to variables,
+passed as arguments and returned from other functions.  A function declared
+inside another function is a closure, it can read and modify the variables
+of the enclosing function, even after the enclosing function has returned.
+*/
+
+// InitFunctions announces the functions chapter.
+func InitFunctions() {
+	common.AnnounceChapter("Functions")
+}
+
+// Counter returns a closure that increments and returns a captured count on
+// each call.  Every call to Counter creates a brand new count variable, so two
+// counters never share state.
+func Counter() func() int {
+	count := 0
+	return func() int {
+		count++
+		return count
+	}
+}
+
+// MakeAdder returns a closure that adds n to its argument.  n is captured at
+// the time MakeAdder is called.
+func MakeAdder(n int) func(int) int {
+	return func(x int) int {
+		return x + n
+	}
+}
+
+// Sum adds up any number of integers.  A variadic parameter must be the last
+// (or only) parameter, inside the function nums is simply a []int.  Callers can
+// pass individual values, nothing at all, or spread an existing slice with s...
+func Sum(nums ...int) int {
+	total := 0
+	for _, n := range nums {
+		total += n
+	}
+	return total
+}
+
+// DivMod returns both the quotient and remainder of a / b.  The results are
+// named, which documents them and pre-declares them as zero valued variables,
+// allowing a bare return.  Just like the / operator, dividing by zero panics.
+func DivMod(a, b int) (quotient, remainder int) {
+	quotient = a / b
+	remainder = a % b
+	return
+}
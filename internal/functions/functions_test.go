@@ -0,0 +1,59 @@
+package functions
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCounter(t *testing.T) {
+	counter := Counter()
+	assert.Equal(t, counter(), 1)
+	assert.Equal(t, counter(), 2)
+	assert.Equal(t, counter(), 3)
+}
+
+func TestCountersAreIndependent(t *testing.T) {
+	first := Counter()
+	second := Counter()
+	first()
+	first()
+	// second has its own captured count, untouched by first.
+	assert.Equal(t, second(), 1)
+	assert.Equal(t, first(), 3)
+}
+
+func TestMakeAdder(t *testing.T) {
+	addTwo := MakeAdder(2)
+	addTen := MakeAdder(10)
+	assert.Equal(t, addTwo(5), 7)
+	assert.Equal(t, addTen(5), 15)
+	// Functions are values, they can be stored in collections too.
+	adders := []func(int) int{addTwo, addTen}
+	assert.Equal(t, adders[1](adders[0](0)), 12)
+}
+
+func TestSumVariadic(t *testing.T) {
+	assert.Equal(t, Sum(), 0)
+	assert.Equal(t, Sum(1, 2, 3), 6)
+
+	// An existing slice can be spread into the variadic parameter.
+	xs := []int{10, 20, 30}
+	assert.Equal(t, Sum(xs...), 60)
+}
+
+func TestDivModNamedReturns(t *testing.T) {
+	quotient, remainder := DivMod(17, 5)
+	assert.Equal(t, quotient, 3)
+	assert.Equal(t, remainder, 2)
+
+	// Integer division truncates towards zero, so the remainder takes
+	// the sign of the dividend.
+	quotient, remainder = DivMod(-17, 5)
+	assert.Equal(t, quotient, -3)
+	assert.Equal(t, remainder, -2)
+}
+
+func TestDivModByZeroPanics(t *testing.T) {
+	assert.Panics(t, func() { DivMod(1, 0) })
+}
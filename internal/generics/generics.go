@@ -0,0 +1,36 @@
+package generics
+
+import (
+	"cmp"
+
+	"github.com/symonk/learning-go-book/internal/common"
+)
+
+/*
+Generics (type parameters) allow writing a function or type once and using it
+with many types, while keeping compile time type safety.  A type parameter is
+declared in square brackets along with a constraint, an interface describing
+what the type must support.  cmp.Ordered for example permits any type that
+supports < <= >= >, which covers the integers, floats and strings.
+*/
+
+// InitGenerics announces the generics chapter.
+func InitGenerics() {
+	common.AnnounceChapter("Generics")
+}
+
+// Min returns the smaller of a and b, or a if they are equal.
+func Min[T cmp.Ordered](a, b T) T {
+	if b < a {
+		return b
+	}
+	return a
+}
+
+// Max returns the larger of a and b, or a if they are equal.
+func Max[T cmp.Ordered](a, b T) T {
+	if b > a {
+		return b
+	}
+	return a
+}
@@ -0,0 +1,36 @@
+package generics
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMinMaxInts(t *testing.T) {
+	assert.Equal(t, Min(3, 7), 3)
+	assert.Equal(t, Max(3, 7), 7)
+	assert.Equal(t, Min(-1, -5), -5)
+}
+
+func TestMinMaxFloats(t *testing.T) {
+	assert.Equal(t, Min(2.5, 1.5), 1.5)
+	assert.Equal(t, Max(2.5, 1.5), 2.5)
+}
+
+func TestMinMaxStrings(t *testing.T) {
+	// Strings are ordered byte by byte, lexicographically, "a" < "b".
+	assert.Equal(t, Min("b", "a"), "a")
+	assert.Equal(t, Max("b", "a"), "b")
+	assert.Equal(t, Max("AAAAA", "AA"), "AAAAA")
+}
+
+func TestMinMaxEqual(t *testing.T) {
+	assert.Equal(t, Min(4, 4), 4)
+	assert.Equal(t, Max("x", "x"), "x")
+}
+
+func TestMinMaxExplicitInstantiation(t *testing.T) {
+	// The type argument is usually inferred, but can be given explicitly,
+	// here the untyped constants become float64.
+	assert.Equal(t, Max[float64](1, 2), 2.0)
+}
@@ -0,0 +1,23 @@
+package generics
+
+// Number is a constraint built from a type union.  The ~ (tilde) means "any
+// type whose underlying type is", so ~float64 permits float64 itself and also
+// named types declared as `type X float64`.  Without the tilde only the exact
+// type would be permitted.
+type Number interface {
+	~int | ~int64 | ~float64
+}
+
+// Celsius is a named type with an underlying type of float64, it satisfies
+// Number only because of the ~ in ~float64.
+type Celsius float64
+
+// SumNumbers adds up every value in xs.  The result has the same type as the
+// input, so summing a []Celsius gives back a Celsius.
+func SumNumbers[T Number](xs []T) T {
+	var total T
+	for _, x := range xs {
+		total += x
+	}
+	return total
+}
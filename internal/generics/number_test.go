@@ -0,0 +1,24 @@
+package generics
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSumNumbersBuiltins(t *testing.T) {
+	assert.Equal(t, SumNumbers([]float64{1.5, 2.5, 3}), 7.0)
+	assert.Equal(t, SumNumbers([]int{1, 2, 3}), 6)
+	assert.Equal(t, SumNumbers([]int64{}), int64(0))
+}
+
+func TestSumNumbersNamedType(t *testing.T) {
+	temps := []Celsius{20.5, 21, 18.5}
+	total := SumNumbers(temps)
+	// The named type is preserved in the result.
+	assert.IsType(t, Celsius(0), total)
+	assert.Equal(t, total, Celsius(60))
+
+	// If Number used float64 instead of ~float64, the above would not compile:
+	// Celsius does not satisfy Number (possibly missing ~ for float64 in Number)
+}
@@ -0,0 +1,27 @@
+package generics
+
+// Pair holds two values of (possibly) different types.  Types can have type
+// parameters too, not just functions.
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+// MapSecond applies f to the second element of p, keeping the first.  Note the
+// extra type parameter C, the output pair can have a different type to the
+// input.  Methods cannot declare their own type parameters, which is why this
+// is a function rather than a method on Pair.
+func MapSecond[A, B, C any](p Pair[A, B], f func(B) C) Pair[A, C] {
+	return Pair[A, C]{First: p.First, Second: f(p.Second)}
+}
+
+// Zip pairs up the elements of as and bs by index, stopping at the end of
+// the shorter slice.
+func Zip[A, B any](as []A, bs []B) []Pair[A, B] {
+	n := min(len(as), len(bs))
+	out := make([]Pair[A, B], n)
+	for i := range out {
+		out[i] = Pair[A, B]{First: as[i], Second: bs[i]}
+	}
+	return out
+}
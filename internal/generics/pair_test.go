@@ -0,0 +1,30 @@
+package generics
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestZip(t *testing.T) {
+	names := []string{"alice", "bob", "carol"}
+	ages := []int{30, 25}
+	zipped := Zip(names, ages)
+	assert.Len(t, zipped, 2)
+	assert.Equal(t, zipped, []Pair[string, int]{
+		{First: "alice", Second: 30},
+		{First: "bob", Second: 25},
+	})
+
+	assert.Len(t, Zip(ages, names), 2)
+	assert.Empty(t, Zip(names, []int{}))
+}
+
+func TestMapSecond(t *testing.T) {
+	p := Pair[string, int]{First: "alice", Second: 30}
+	mapped := MapSecond(p, strconv.Itoa)
+	assert.Equal(t, mapped, Pair[string, string]{First: "alice", Second: "30"})
+	// The original pair is a value, it is untouched.
+	assert.Equal(t, p.Second, 30)
+}
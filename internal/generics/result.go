@@ -0,0 +1,56 @@
+package generics
+
+// Result holds either a value or an error, never both.  Go normally returns
+// (T, error) pairs, but a Result can be handy to store or pass around as a
+// single value, for example when collecting results from a channel.
+type Result[T any] struct {
+	value T
+	err   error
+}
+
+// Ok returns a successful Result holding v.
+func Ok[T any](v T) Result[T] {
+	return Result[T]{value: v}
+}
+
+// Err returns a failed Result holding err.
+func Err[T any](err error) Result[T] {
+	return Result[T]{err: err}
+}
+
+// IsOk reports whether the Result holds a value rather than an error.
+func (r Result[T]) IsOk() bool {
+	return r.err == nil
+}
+
+// Err returns the error held by the Result, or nil.  It is deliberately not
+// called Error, a method named Error that does not return a string looks like
+// the error interface without actually satisfying it.
+func (r Result[T]) Err() error {
+	return r.err
+}
+
+// Unwrap returns the value, panicking if the Result holds an error.
+func (r Result[T]) Unwrap() T {
+	if r.err != nil {
+		panic(r.err)
+	}
+	return r.value
+}
+
+// UnwrapOr returns the value, or fallback if the Result holds an error.
+func (r Result[T]) UnwrapOr(fallback T) T {
+	if r.err != nil {
+		return fallback
+	}
+	return r.value
+}
+
+// Map transforms the value of r with f.  If r holds an error, f is never
+// called and the error is carried through to the new Result untouched.
+func Map[T, U any](r Result[T], f func(T) U) Result[U] {
+	if r.err != nil {
+		return Err[U](r.err)
+	}
+	return Ok(f(r.value))
+}
@@ -0,0 +1,44 @@
+package generics
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResultOk(t *testing.T) {
+	r := Ok(10)
+	assert.True(t, r.IsOk())
+	assert.NoError(t, r.Err())
+	assert.Equal(t, r.Unwrap(), 10)
+	assert.Equal(t, r.UnwrapOr(-1), 10)
+}
+
+func TestResultErr(t *testing.T) {
+	boom := errors.New("boom")
+	r := Err[int](boom)
+	assert.False(t, r.IsOk())
+	assert.ErrorIs(t, r.Err(), boom)
+	assert.Equal(t, r.UnwrapOr(-1), -1)
+	assert.PanicsWithError(t, "boom", func() { r.Unwrap() })
+}
+
+func TestMapTransformsValue(t *testing.T) {
+	mapped := Map(Ok(42), strconv.Itoa)
+	assert.True(t, mapped.IsOk())
+	assert.Equal(t, mapped.Unwrap(), "42")
+}
+
+func TestMapShortCircuitsOnError(t *testing.T) {
+	boom := errors.New("boom")
+	called := false
+	mapped := Map(Err[int](boom), func(v int) string {
+		called = true
+		return strconv.Itoa(v)
+	})
+	assert.False(t, called)
+	assert.False(t, mapped.IsOk())
+	assert.ErrorIs(t, mapped.Err(), boom)
+}
@@ -0,0 +1,20 @@
+package generics
+
+// IndexOf returns the index of the first occurrence of target in s, or -1 if
+// it is not present.  The comparable constraint is required as == is used,
+// which not every type supports (slices, maps and funcs cannot be compared).
+func IndexOf[T comparable](s []T, target T) int {
+	for i, v := range s {
+		if v == target {
+			return i
+		}
+	}
+	return -1
+}
+
+// Contains reports whether target is present in s.
+// Note: the stdlib `slices` package provides both of these, they are
+// implemented here purely to demonstrate type constraints.
+func Contains[T comparable](s []T, target T) bool {
+	return IndexOf(s, target) >= 0
+}
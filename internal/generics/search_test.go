@@ -0,0 +1,38 @@
+package generics
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIndexOfInts(t *testing.T) {
+	s := []int{10, 20, 30, 40}
+	assert.Equal(t, IndexOf(s, 10), 0)
+	assert.Equal(t, IndexOf(s, 30), 2)
+	assert.Equal(t, IndexOf(s, 40), 3)
+	assert.Equal(t, IndexOf(s, 50), -1)
+	assert.Equal(t, IndexOf(nil, 1), -1)
+}
+
+func TestIndexOfStrings(t *testing.T) {
+	s := []string{"foo", "bar", "baz", "bar"}
+	assert.Equal(t, IndexOf(s, "foo"), 0)
+	// Only the first occurrence is reported.
+	assert.Equal(t, IndexOf(s, "bar"), 1)
+	assert.Equal(t, IndexOf(s, "qux"), -1)
+}
+
+func TestContains(t *testing.T) {
+	ints := []int{1, 2, 3}
+	assert.True(t, Contains(ints, 1))
+	assert.True(t, Contains(ints, 2))
+	assert.True(t, Contains(ints, 3))
+	assert.False(t, Contains(ints, 4))
+
+	strs := []string{"a", "b", "c"}
+	assert.True(t, Contains(strs, "a"))
+	assert.True(t, Contains(strs, "b"))
+	assert.True(t, Contains(strs, "c"))
+	assert.False(t, Contains(strs, "d"))
+}
@@ -0,0 +1,25 @@
+package interfaces
+
+import "fmt"
+
+// Describe returns a human readable description of v.  `any` (an alias for
+// interface{}) can hold a value of any type, a type switch is the idiomatic
+// way to branch on the concrete type inside it.  Within each case, value has
+// the type of that case, so len() works on the slice and map cases.
+func Describe(v any) string {
+	switch value := v.(type) {
+	case int:
+		return fmt.Sprintf("an int: %d", value)
+	case string:
+		return fmt.Sprintf("a string of %d bytes: %q", len(value), value)
+	case []int:
+		return fmt.Sprintf("a slice of %d ints", len(value))
+	case map[string]int:
+		return fmt.Sprintf("a map of %d string->int entries", len(value))
+	case nil:
+		return "nil"
+	default:
+		// In the default case value is just v, still typed as any.
+		return fmt.Sprintf("an unhandled type: %T", value)
+	}
+}
@@ -0,0 +1,21 @@
+package interfaces
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDescribe(t *testing.T) {
+	assert.Equal(t, Describe(42), "an int: 42")
+	assert.Equal(t, Describe("héllo"), `a string of 6 bytes: "héllo"`)
+	assert.Equal(t, Describe([]int{1, 2, 3}), "a slice of 3 ints")
+	assert.Equal(t, Describe(map[string]int{"a": 1}), "a map of 1 string->int entries")
+	assert.Equal(t, Describe(nil), "nil")
+}
+
+func TestDescribeDefault(t *testing.T) {
+	assert.Equal(t, Describe(3.14), "an unhandled type: float64")
+	// Type switches match exact types, a named int is not an int.
+	assert.Equal(t, Describe(Temperature(1)), "an unhandled type: interfaces.Temperature")
+}
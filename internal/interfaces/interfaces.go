@@ -0,0 +1,29 @@
+package interfaces
+
+import (
+	"fmt"
+
+	"github.com/symonk/learning-go-book/internal/common"
+)
+
+/*
+Any type can declare methods, not just structs.  An interface is a set of
+methods, and a type satisfies an interface implicitly simply by having those
+methods, there is no `implements` keyword.  The set of methods a type has is
+known as its method set.
+*/
+
+// InitInterfaces announces the interfaces chapter.
+func InitInterfaces() {
+	common.AnnounceChapter("Types, Methods & Interfaces")
+}
+
+// Temperature is a named float64 in degrees celsius.  It has an underlying type
+// of float64, but is a distinct type that we can attach methods to.
+type Temperature float64
+
+// String implements fmt.Stringer, which the fmt package checks for when
+// formatting a value with %v or %s.
+func (t Temperature) String() string {
+	return fmt.Sprintf("%.1f°C", float64(t))
+}
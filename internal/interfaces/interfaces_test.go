@@ -0,0 +1,25 @@
+package interfaces
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// A compile time check that Temperature satisfies fmt.Stringer.
+var _ fmt.Stringer = Temperature(0)
+
+func TestTemperatureStringer(t *testing.T) {
+	temp := Temperature(21.5)
+	assert.Equal(t, fmt.Sprintf("%v", temp), "21.5°C")
+	assert.Equal(t, fmt.Sprintf("It is %s outside", Temperature(-3)), "It is -3.0°C outside")
+}
+
+func TestTemperatureIsStillANumber(t *testing.T) {
+	// The underlying type is float64, so arithmetic still works.
+	temp := Temperature(20) + 1.5
+	assert.Equal(t, temp.String(), "21.5°C")
+	// Converting back to float64 drops the method, and the custom formatting.
+	assert.Equal(t, fmt.Sprintf("%v", float64(temp)), "21.5")
+}
@@ -0,0 +1,29 @@
+package interfaces
+
+// Counter demonstrates the difference between pointer and value receivers.
+type Counter struct {
+	Count int
+}
+
+// IncPointer has a pointer receiver, it modifies the Counter it is called on.
+// Note: only *Counter has this method in its method set, not Counter.
+func (c *Counter) IncPointer() {
+	c.Count++
+}
+
+// SnapshotValue has a value receiver, it operates on (and returns) a copy.
+// Both Counter and *Counter have this method in their method sets.
+func (c Counter) SnapshotValue() Counter {
+	return c
+}
+
+// Incrementer is satisfied by anything with an IncPointer method, which
+// for Counter means only a *Counter.
+type Incrementer interface {
+	IncPointer()
+}
+
+// Snapshotter is satisfied by both Counter and *Counter.
+type Snapshotter interface {
+	SnapshotValue() Counter
+}
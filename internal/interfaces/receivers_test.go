@@ -0,0 +1,55 @@
+package interfaces
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValueInInterfaceLacksPointerMethods(t *testing.T) {
+	c := Counter{}
+	// Storing c in an interface copies it.  The copy inside the interface is
+	// not addressable, so go cannot take its address to call IncPointer, and
+	// therefore Counter (the value) does not satisfy Incrementer.
+	var boxed any = c
+	_, ok := boxed.(Incrementer)
+	assert.False(t, ok)
+
+	// compile error: var _ Incrementer = c
+	// Counter does not implement Incrementer (method IncPointer has pointer receiver)
+
+	// Calling directly on an addressable variable is fine, go rewrites c.IncPointer()
+	// as (&c).IncPointer() for us.  The boxed copy is still untouched.
+	c.IncPointer()
+	assert.Equal(t, c.Count, 1)
+	assert.Equal(t, boxed.(Counter).Count, 0)
+}
+
+func TestPointerInInterfaceMutates(t *testing.T) {
+	c := Counter{}
+	var boxed any = &c
+	incrementer, ok := boxed.(Incrementer)
+	assert.True(t, ok)
+
+	incrementer.IncPointer()
+	incrementer.IncPointer()
+	// The interface holds the address of c, so c itself was modified.
+	assert.Equal(t, c.Count, 2)
+}
+
+func TestValueMethodsInBothMethodSets(t *testing.T) {
+	c := Counter{Count: 5}
+	var byValue Snapshotter = c
+	var byPointer Snapshotter = &c
+
+	c.IncPointer()
+	// byValue holds a copy made before the increment, byPointer sees c.
+	assert.Equal(t, byValue.SnapshotValue().Count, 5)
+	assert.Equal(t, byPointer.SnapshotValue().Count, 6)
+
+	// The snapshot itself is a copy, modifying it does not touch c.
+	snapshot := c.SnapshotValue()
+	snapshot.IncPointer()
+	assert.Equal(t, snapshot.Count, 7)
+	assert.Equal(t, c.Count, 6)
+}
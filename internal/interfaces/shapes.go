@@ -0,0 +1,46 @@
+package interfaces
+
+import "math"
+
+// Shape is anything that can report its area.
+type Shape interface {
+	Area() float64
+}
+
+// Rectangle is a Shape with a width and height.
+type Rectangle struct {
+	Width, Height float64
+}
+
+// Area returns the width multiplied by the height.
+func (r Rectangle) Area() float64 {
+	return r.Width * r.Height
+}
+
+// Circle is a Shape with a radius.
+type Circle struct {
+	Radius float64
+}
+
+// Area returns pi r squared.
+func (c Circle) Area() float64 {
+	return math.Pi * c.Radius * c.Radius
+}
+
+// TotalArea sums the area of any mix of shapes, it only cares that each
+// value satisfies Shape, not what concrete type it is.
+func TotalArea(shapes ...Shape) float64 {
+	var total float64
+	for _, s := range shapes {
+		total += s.Area()
+	}
+	return total
+}
+
+// AsCircle uses the comma ok form of a type assertion to check whether the
+// concrete type held by s is a Circle.  Unlike the single value form
+// s.(Circle), this does not panic when s holds something else.
+func AsCircle(s Shape) (Circle, bool) {
+	c, ok := s.(Circle)
+	return c, ok
+}
@@ -0,0 +1,34 @@
+package interfaces
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTotalArea(t *testing.T) {
+	shapes := []Shape{
+		Rectangle{Width: 2, Height: 3},
+		Circle{Radius: 1},
+		Rectangle{Width: 1, Height: 1},
+	}
+	assert.InDelta(t, TotalArea(shapes...), 7+math.Pi, 1e-9)
+	assert.Zero(t, TotalArea())
+}
+
+func TestAsCircle(t *testing.T) {
+	c, ok := AsCircle(Circle{Radius: 2})
+	assert.True(t, ok)
+	assert.Equal(t, c.Radius, 2.0)
+
+	c, ok = AsCircle(Rectangle{Width: 1, Height: 2})
+	assert.False(t, ok)
+	// On failure the zero value of the asserted type is returned.
+	assert.Equal(t, c, Circle{})
+}
+
+func TestSingleValueAssertionPanics(t *testing.T) {
+	var s Shape = Rectangle{}
+	assert.Panics(t, func() { _ = s.(Circle) })
+}
@@ -0,0 +1,72 @@
+package pointers
+
+// listNode is a single element of a List, holding its value and a pointer to
+// the next node.  The last node has a nil next pointer.
+type listNode[T any] struct {
+	value T
+	next  *listNode[T]
+}
+
+// List is a singly linked list.  Where a slice keeps its elements side by side
+// in one block of memory, each node here is its own allocation, linked to the
+// next by a pointer.  Inserting at the front is O(1) with no copying, the
+// trade off is no O(1) indexing.  A tail pointer is kept alongside the head
+// so PushBack does not have to walk the whole list.  The zero value is an
+// empty list ready to use.
+type List[T any] struct {
+	head   *listNode[T]
+	tail   *listNode[T]
+	length int
+}
+
+// PushFront adds v to the start of the list.
+func (l *List[T]) PushFront(v T) {
+	l.head = &listNode[T]{value: v, next: l.head}
+	if l.tail == nil {
+		l.tail = l.head
+	}
+	l.length++
+}
+
+// PushBack adds v to the end of the list.
+func (l *List[T]) PushBack(v T) {
+	node := &listNode[T]{value: v}
+	if l.tail == nil {
+		l.head = node
+	} else {
+		l.tail.next = node
+	}
+	l.tail = node
+	l.length++
+}
+
+// Len returns the number of elements in the list.
+func (l *List[T]) Len() int {
+	return l.length
+}
+
+// ToSlice returns the elements of the list from front to back.
+func (l *List[T]) ToSlice() []T {
+	out := make([]T, 0, l.length)
+	for node := l.head; node != nil; node = node.next {
+		out = append(out, node.value)
+	}
+	return out
+}
+
+// Reverse reverses the list in place by re-pointing every nodes next pointer
+// at the node before it, no nodes are allocated or copied.  prev trails the
+// current node, and next is saved before it is overwritten so the walk can
+// carry on.
+func (l *List[T]) Reverse() {
+	var prev *listNode[T]
+	current := l.head
+	l.tail = l.head
+	for current != nil {
+		next := current.next
+		current.next = prev
+		prev = current
+		current = next
+	}
+	l.head = prev
+}
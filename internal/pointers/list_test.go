@@ -0,0 +1,42 @@
+package pointers
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListPush(t *testing.T) {
+	var l List[int]
+	l.PushBack(2)
+	l.PushBack(3)
+	l.PushFront(1)
+	assert.Equal(t, l.Len(), 3)
+	assert.True(t, slices.Equal(l.ToSlice(), []int{1, 2, 3}))
+}
+
+func TestListReverse(t *testing.T) {
+	var l List[string]
+	for _, v := range []string{"a", "b", "c", "d"} {
+		l.PushBack(v)
+	}
+	l.Reverse()
+	assert.True(t, slices.Equal(l.ToSlice(), []string{"d", "c", "b", "a"}))
+	assert.Equal(t, l.Len(), 4)
+
+	// The tail is fixed up too, so pushing to the back still works.
+	l.PushBack("z")
+	assert.True(t, slices.Equal(l.ToSlice(), []string{"d", "c", "b", "a", "z"}))
+}
+
+func TestListEmpty(t *testing.T) {
+	var l List[int]
+	l.Reverse()
+	assert.Equal(t, l.Len(), 0)
+	assert.Empty(t, l.ToSlice())
+
+	l.PushFront(1)
+	l.Reverse()
+	assert.Equal(t, l.ToSlice(), []int{1})
+}
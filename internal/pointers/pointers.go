@@ -0,0 +1,52 @@
+package pointers
+
+import "github.com/symonk/learning-go-book/internal/common"
+
+/*
+A pointer is a variable that holds the memory address of another value.
+Go passes everything by value, a function always receives a copy of its
+arguments.  Passing a pointer copies the address, not the value behind it,
+which lets the function modify the callers variable.
+
+	* &x takes the address of x, producing a *T
+	* *p dereferences p, reading (or writing) the value it points at
+	* the zero value of any pointer type is nil
+*/
+
+// InitPointers announces the pointers chapter.
+func InitPointers() {
+	common.AnnounceChapter("Pointers")
+}
+
+// Swap exchanges the values that a and b point at.  Because it receives the
+// addresses rather than copies, the swap is visible to the caller.
+func Swap[T any](a, b *T) {
+	*a, *b = *b, *a
+}
+
+// IncrementValue receives a copy of n, the callers variable is untouched so
+// the only way to see the result is via the return value.
+func IncrementValue(n int) int {
+	n++
+	return n
+}
+
+// IncrementPointer receives the address of n and increments the value
+// in place, the caller sees the change without any return value.
+func IncrementPointer(n *int) {
+	*n++
+}
+
+// ModifySlice sets the first element of s.  The slice header is copied but it
+// still points at the same backing array, so the caller sees the change.
+func ModifySlice(s []int) {
+	if len(s) > 0 {
+		s[0] = 100
+	}
+}
+
+// ModifyArray sets the first element of a.  Arrays are values, the whole array
+// is copied into the function, so the callers array is unaffected.
+func ModifyArray(a [3]int) {
+	a[0] = 100
+}
@@ -0,0 +1,54 @@
+package pointers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSwapIntegers(t *testing.T) {
+	x, y := 1, 2
+	Swap(&x, &y)
+	assert.Equal(t, x, 2)
+	assert.Equal(t, y, 1)
+}
+
+func TestSwapStrings(t *testing.T) {
+	x, y := "foo", "bar"
+	Swap(&x, &y)
+	assert.Equal(t, x, "bar")
+	assert.Equal(t, y, "foo")
+}
+
+// Swapping a value with itself is harmless.
+func TestSwapSamePointer(t *testing.T) {
+	x := 10
+	Swap(&x, &x)
+	assert.Equal(t, x, 10)
+}
+
+func TestIncrementValue(t *testing.T) {
+	n := 10
+	result := IncrementValue(n)
+	assert.Equal(t, result, 11)
+	// n was copied into the function, it is unchanged.
+	assert.Equal(t, n, 10)
+}
+
+func TestIncrementPointer(t *testing.T) {
+	n := 10
+	IncrementPointer(&n)
+	assert.Equal(t, n, 11)
+}
+
+func TestModifySliceSharesBackingArray(t *testing.T) {
+	s := []int{1, 2, 3}
+	ModifySlice(s)
+	assert.Equal(t, s, []int{100, 2, 3})
+}
+
+func TestModifyArrayIsCopied(t *testing.T) {
+	a := [3]int{1, 2, 3}
+	ModifyArray(a)
+	assert.Equal(t, a, [3]int{1, 2, 3})
+}
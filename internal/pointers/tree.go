@@ -0,0 +1,58 @@
+package pointers
+
+import "cmp"
+
+// treeNode is a node in a Tree, each child pointer is nil when there is no
+// subtree on that side.
+type treeNode[T cmp.Ordered] struct {
+	value       T
+	left, right *treeNode[T]
+}
+
+// Tree is an (unbalanced) binary search tree.  Every value in a nodes left
+// subtree is smaller than the node, everything in its right subtree is equal
+// or larger.  The structure is recursive, a node points to two smaller trees,
+// so the operations are naturally written as recursive functions.  Inserting
+// already sorted data degrades it into a linked list.  The zero value is an
+// empty tree ready to use.
+type Tree[T cmp.Ordered] struct {
+	root *treeNode[T]
+}
+
+// Insert adds v to the tree.  Duplicates are kept.
+func (t *Tree[T]) Insert(v T) {
+	t.root = insert(t.root, v)
+}
+
+// insert returns n with v added beneath it, a nil n is an empty subtree so
+// the new node takes its place.
+func insert[T cmp.Ordered](n *treeNode[T], v T) *treeNode[T] {
+	if n == nil {
+		return &treeNode[T]{value: v}
+	}
+	if v < n.value {
+		n.left = insert(n.left, v)
+	} else {
+		n.right = insert(n.right, v)
+	}
+	return n
+}
+
+// InOrder returns every value in the tree in sorted order, visiting the left
+// subtree, then the node, then the right subtree.
+func (t *Tree[T]) InOrder() []T {
+	var out []T
+	inOrder(t.root, &out)
+	return out
+}
+
+// inOrder appends the values beneath n to out.  A pointer to the slice is
+// passed so that appends made deeper in the recursion are seen by the caller.
+func inOrder[T cmp.Ordered](n *treeNode[T], out *[]T) {
+	if n == nil {
+		return
+	}
+	inOrder(n.left, out)
+	*out = append(*out, n.value)
+	inOrder(n.right, out)
+}
@@ -0,0 +1,35 @@
+package pointers
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTreeInOrderSorted(t *testing.T) {
+	values := rand.New(rand.NewSource(1)).Perm(50)
+	values = append(values, 7, 7, 31)
+
+	var tree Tree[int]
+	for _, v := range values {
+		tree.Insert(v)
+	}
+	expected := slices.Clone(values)
+	slices.Sort(expected)
+	assert.Equal(t, tree.InOrder(), expected)
+}
+
+func TestTreeStrings(t *testing.T) {
+	var tree Tree[string]
+	for _, v := range []string{"m", "c", "x", "a", "e"} {
+		tree.Insert(v)
+	}
+	assert.Equal(t, tree.InOrder(), []string{"a", "c", "e", "m", "x"})
+}
+
+func TestTreeEmpty(t *testing.T) {
+	var tree Tree[float64]
+	assert.Empty(t, tree.InOrder())
+}
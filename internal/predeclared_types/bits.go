@@ -0,0 +1,53 @@
+package predeclared_types
+
+import "fmt"
+
+// uint64Bits is the number of bits in a uint64, positions run from 0 (the
+// least significant bit) to 63.
+const uint64Bits = 64
+
+// SetBit returns n with the bit at pos switched on.  1 << pos builds a mask
+// with only that bit set, OR'ing it in leaves every other bit untouched.
+func SetBit(n uint64, pos uint) uint64 {
+	checkBitPosition(pos)
+	return n | 1<<pos
+}
+
+// ClearBit returns n with the bit at pos switched off.  &^ (AND NOT, or bit
+// clear) zeroes every bit of n that is set in the mask.
+func ClearBit(n uint64, pos uint) uint64 {
+	checkBitPosition(pos)
+	return n &^ (1 << pos)
+}
+
+// IsBitSet reports whether the bit at pos is switched on in n.
+func IsBitSet(n uint64, pos uint) bool {
+	checkBitPosition(pos)
+	return n&(1<<pos) != 0
+}
+
+// checkBitPosition panics for positions beyond the width of a uint64.  Go
+// would happily shift past the end, 1 << 64 is simply 0, silently turning the
+// helpers into no-ops.
+func checkBitPosition(pos uint) {
+	if pos >= uint64Bits {
+		panic(fmt.Sprintf("bit position %d out of range for uint64", pos))
+	}
+}
+
+// TwosComplement returns the raw bit pattern of n read as an unsigned byte.
+// Signed integers are stored in two's complement, a negative number -x is
+// held as 2^8 - x, so -1 is 1111_1111 (255) and -128 is 1000_0000 (128).
+// This is why incrementing the largest signed value wraps to the most
+// negative one, as seen in exerciseThree, 0111_1111 + 1 is 1000_0000.
+// Converting between integer types of the same size keeps the bits as they
+// are, only how they are interpreted changes.
+func TwosComplement(n int8) uint8 {
+	return uint8(n)
+}
+
+// FromTwosComplement is the reverse of TwosComplement, reading the bit
+// pattern b as a signed int8.  Any byte with the top bit set is negative.
+func FromTwosComplement(b uint8) int8 {
+	return int8(b)
+}
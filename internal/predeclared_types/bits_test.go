@@ -0,0 +1,63 @@
+package predeclared_types
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetAndClearBit(t *testing.T) {
+	var n uint64
+	n = SetBit(n, 0)
+	n = SetBit(n, 3)
+	assert.Equal(t, n, uint64(0b1001))
+	assert.True(t, IsBitSet(n, 0))
+	assert.False(t, IsBitSet(n, 1))
+	assert.True(t, IsBitSet(n, 3))
+
+	n = ClearBit(n, 0)
+	assert.Equal(t, n, uint64(0b1000))
+	assert.False(t, IsBitSet(n, 0))
+
+	// Setting an already set bit (or clearing a clear one) changes nothing.
+	assert.Equal(t, SetBit(n, 3), n)
+	assert.Equal(t, ClearBit(n, 0), n)
+}
+
+func TestHighBit(t *testing.T) {
+	// Unsigned ints use the top bit for value rather than sign.
+	n := SetBit(0, 63)
+	assert.Equal(t, n, uint64(1<<63))
+	assert.True(t, IsBitSet(n, 63))
+	assert.Equal(t, ClearBit(math.MaxUint64, 63), uint64(math.MaxInt64))
+}
+
+func TestBitPositionOutOfRange(t *testing.T) {
+	assert.Panics(t, func() { SetBit(0, 64) })
+	assert.Panics(t, func() { ClearBit(0, 64) })
+	assert.Panics(t, func() { IsBitSet(0, 100) })
+}
+
+func TestTwosComplement(t *testing.T) {
+	assert.Equal(t, TwosComplement(-1), uint8(255))
+	assert.Equal(t, TwosComplement(-128), uint8(128))
+	assert.Equal(t, TwosComplement(0), uint8(0))
+	assert.Equal(t, TwosComplement(127), uint8(127))
+
+	assert.Equal(t, FromTwosComplement(255), int8(-1))
+	assert.Equal(t, FromTwosComplement(128), int8(-128))
+
+	// Every value survives the round trip.
+	for n := math.MinInt8; n <= math.MaxInt8; n++ {
+		assert.Equal(t, FromTwosComplement(TwosComplement(int8(n))), int8(n))
+	}
+}
+
+func TestTwosComplementWrap(t *testing.T) {
+	// The largest int8 plus one wraps to the smallest.
+	var n int8 = math.MaxInt8
+	n++
+	assert.Equal(t, n, int8(math.MinInt8))
+	assert.Equal(t, TwosComplement(n), uint8(0b1000_0000))
+}
@@ -0,0 +1,19 @@
+package predeclared_types
+
+import "math"
+
+// FloatEqual reports whether a and b are within epsilon of each other.
+// Floating point arithmetic rounds, so values which should be equal often
+// differ in the last few bits (0.1 + 0.2 != 0.3), comparing against a
+// tolerance is usually what is wanted rather than ==.  NaN is not equal to
+// anything, itself included, so either operand being NaN is always false.
+// Matching infinities are treated as equal.
+func FloatEqual(a, b, epsilon float64) bool {
+	if math.IsNaN(a) || math.IsNaN(b) {
+		return false
+	}
+	if a == b {
+		return true
+	}
+	return math.Abs(a-b) <= epsilon
+}
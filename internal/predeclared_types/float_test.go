@@ -0,0 +1,32 @@
+package predeclared_types
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// tenth and fifth are variables, as constant arithmetic is exact and
+// 0.1 + 0.2 written as constants would be exactly 0.3.
+var tenth, fifth = 0.1, 0.2
+
+func TestFloatEqualWithinEpsilon(t *testing.T) {
+	assert.NotEqual(t, tenth+fifth, 0.3)
+	assert.True(t, FloatEqual(tenth+fifth, 0.3, 1e-9))
+	assert.True(t, FloatEqual(1.0, 1.05, 0.1))
+	assert.True(t, FloatEqual(math.Inf(1), math.Inf(1), 1e-9))
+}
+
+func TestFloatEqualOutsideEpsilon(t *testing.T) {
+	assert.False(t, FloatEqual(1.0, 1.2, 0.1))
+	assert.False(t, FloatEqual(tenth+fifth, 0.3, 0))
+	assert.False(t, FloatEqual(math.Inf(1), math.Inf(-1), math.MaxFloat64))
+}
+
+func TestFloatEqualNaN(t *testing.T) {
+	nan := math.NaN()
+	assert.False(t, FloatEqual(nan, 1, math.Inf(1)))
+	assert.False(t, FloatEqual(1, nan, math.Inf(1)))
+	assert.False(t, FloatEqual(nan, nan, math.Inf(1)))
+}
@@ -0,0 +1,29 @@
+package predeclared_types
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+var (
+	// ErrNotANumber is returned when a string cannot be parsed as an integer.
+	ErrNotANumber = errors.New("not a number")
+	// ErrOutOfRange is returned when a parsed integer falls outside the allowed bounds.
+	ErrOutOfRange = errors.New("out of range")
+)
+
+// ParseBoundedInt parses s as a base 10 int and checks it lies within the
+// inclusive range [min, max].  Each failure wraps its own sentinel error, so
+// callers can tell bad input apart from a valid number that is too big or
+// too small with errors.Is.
+func ParseBoundedInt(s string, min, max int) (int, error) {
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q: %w", ErrNotANumber, s, err)
+	}
+	if n < min || n > max {
+		return 0, fmt.Errorf("%w: %d not in [%d, %d]", ErrOutOfRange, n, min, max)
+	}
+	return n, nil
+}
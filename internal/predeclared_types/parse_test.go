@@ -0,0 +1,40 @@
+package predeclared_types
+
+import (
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseBoundedInt(t *testing.T) {
+	n, err := ParseBoundedInt("42", 0, 100)
+	assert.NoError(t, err)
+	assert.Equal(t, n, 42)
+
+	// The bounds are inclusive.
+	n, err = ParseBoundedInt("-5", -5, 5)
+	assert.NoError(t, err)
+	assert.Equal(t, n, -5)
+}
+
+func TestParseBoundedIntOutOfRange(t *testing.T) {
+	_, err := ParseBoundedInt("101", 0, 100)
+	assert.ErrorIs(t, err, ErrOutOfRange)
+	assert.NotErrorIs(t, err, ErrNotANumber)
+	assert.EqualError(t, err, "out of range: 101 not in [0, 100]")
+}
+
+func TestParseBoundedIntNotANumber(t *testing.T) {
+	_, err := ParseBoundedInt("forty two", 0, 100)
+	assert.ErrorIs(t, err, ErrNotANumber)
+	assert.NotErrorIs(t, err, ErrOutOfRange)
+	// The underlying strconv error is wrapped too.
+	assert.ErrorIs(t, err, strconv.ErrSyntax)
+
+	// Too big for an int is a parse failure rather than out of range.
+	_, err = ParseBoundedInt("99999999999999999999", math.MinInt, math.MaxInt)
+	assert.ErrorIs(t, err, ErrNotANumber)
+	assert.ErrorIs(t, err, strconv.ErrRange)
+}
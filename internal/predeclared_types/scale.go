@@ -0,0 +1,17 @@
+package predeclared_types
+
+import "github.com/symonk/learning-go-book/internal/constraints"
+
+// scaleFactor is untyped, it has no fixed type until it is used, so it can
+// be combined with any numeric type able to represent 100.  A typed constant
+// (scaleFactor int = 100) could only be multiplied with an int, see
+// TestUntypedConstants.
+const scaleFactor = 100
+
+// ScaleUntyped multiplies base by scaleFactor.  The same constant is accepted
+// for every type in the constraint, ints and floats alike, without a cast.
+// The compiler checks 100 fits every type T could be, were the factor 1000
+// this would fail to compile, as int8 and uint8 cannot hold it.
+func ScaleUntyped[T constraints.Integer | constraints.Float](base T) T {
+	return base * scaleFactor
+}
@@ -0,0 +1,18 @@
+package predeclared_types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScaleUntyped(t *testing.T) {
+	// One untyped constant, used as an int and as a float64.
+	assert.Equal(t, ScaleUntyped(3), 300)
+	assert.Equal(t, ScaleUntyped(1.5), 150.0)
+
+	var small int8 = 1
+	assert.Equal(t, ScaleUntyped(small), int8(100))
+	assert.Equal(t, ScaleUntyped(uint64(7)), uint64(700))
+	assert.Equal(t, ScaleUntyped(float32(0.25)), float32(25))
+}
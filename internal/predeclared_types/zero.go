@@ -0,0 +1,31 @@
+package predeclared_types
+
+// ZeroValues maps type names to the zero value of that type, the value every
+// variable starts with when declared without an initial value.  Numbers are
+// 0, strings are "", bools are false, and slices, maps and pointers are nil.
+//
+// Note that a nil slice or map stored in an any is not itself a nil any, the
+// interface still records the type, so ZeroValues()["[]int"] == nil is false
+// even though the slice it holds is nil.
+func ZeroValues() map[string]any {
+	var (
+		i  int
+		s  string
+		b  bool
+		f  float64
+		r  rune
+		sl []int
+		m  map[string]int
+		p  *int
+	)
+	return map[string]any{
+		"int":            i,
+		"string":         s,
+		"bool":           b,
+		"float64":        f,
+		"rune":           r,
+		"[]int":          sl,
+		"map[string]int": m,
+		"*int":           p,
+	}
+}
@@ -0,0 +1,29 @@
+package predeclared_types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestZeroValues(t *testing.T) {
+	zeros := ZeroValues()
+	assert.Equal(t, zeros["int"], 0)
+	assert.Equal(t, zeros["string"], "")
+	assert.Equal(t, zeros["bool"], false)
+	assert.Equal(t, zeros["float64"], 0.0)
+	assert.Equal(t, zeros["rune"], rune(0))
+	assert.Equal(t, zeros["map[string]int"], map[string]int(nil))
+	assert.Equal(t, zeros["*int"], (*int)(nil))
+	assert.Len(t, zeros, 8)
+}
+
+func TestZeroValueSliceIsNil(t *testing.T) {
+	slice, ok := ZeroValues()["[]int"].([]int)
+	assert.True(t, ok)
+	// nil, not an empty slice.
+	assert.True(t, slice == nil)
+	assert.NotEqual(t, slice, []int{})
+	// The any holding it is not nil, it still knows its type.
+	assert.False(t, ZeroValues()["[]int"] == nil)
+}
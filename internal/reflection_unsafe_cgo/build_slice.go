@@ -0,0 +1,30 @@
+package reflection_unsafe_go
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// ErrElementType is returned by BuildSlice when a value cannot be stored in
+// a slice of the requested element type.
+var ErrElementType = errors.New("value does not match slice element type")
+
+// BuildSlice constructs a slice of elem at runtime from loosely typed values.
+// reflect.MakeSlice creates the (empty, pre-sized) slice and reflect.Append
+// grows it, the reflect equivalents of make and append.  The returned `any`
+// holds the concretely typed slice, so a []int can be asserted back out of it.
+func BuildSlice(elem reflect.Type, values ...any) (any, error) {
+	if elem == nil {
+		return nil, fmt.Errorf("%w: nil element type", ErrElementType)
+	}
+	slice := reflect.MakeSlice(reflect.SliceOf(elem), 0, len(values))
+	for i, value := range values {
+		v := reflect.ValueOf(value)
+		if !v.IsValid() || !v.Type().AssignableTo(elem) {
+			return nil, fmt.Errorf("%w: index %d is %T, want %v", ErrElementType, i, value, elem)
+		}
+		slice = reflect.Append(slice, v)
+	}
+	return slice.Interface(), nil
+}
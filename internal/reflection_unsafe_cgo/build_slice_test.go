@@ -0,0 +1,32 @@
+package reflection_unsafe_go
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildSliceInts(t *testing.T) {
+	built, err := BuildSlice(reflect.TypeOf(0), 1, 2, 3)
+	assert.NoError(t, err)
+	ints, ok := built.([]int)
+	assert.True(t, ok)
+	assert.Equal(t, ints, []int{1, 2, 3})
+	assert.Equal(t, cap(ints), 3)
+}
+
+func TestBuildSliceEmpty(t *testing.T) {
+	built, err := BuildSlice(reflect.TypeOf(""))
+	assert.NoError(t, err)
+	assert.Equal(t, built, []string{})
+}
+
+func TestBuildSliceMismatchedType(t *testing.T) {
+	built, err := BuildSlice(reflect.TypeOf(0), 1, "two", 3)
+	assert.ErrorIs(t, err, ErrElementType)
+	assert.Nil(t, built)
+
+	_, err = BuildSlice(reflect.TypeOf(0), nil)
+	assert.ErrorIs(t, err, ErrElementType)
+}
@@ -0,0 +1,101 @@
+package reflection_unsafe_go
+
+import "reflect"
+
+// visit records a pair of values that are currently being compared.  If we
+// see the same pair again while still comparing them, we have walked a cycle
+// and can assume they are equal (any difference will be found elsewhere).
+type visit struct {
+	a, b uintptr
+	typ  reflect.Type
+}
+
+// DeepEqual is a hand rolled (and simplified) version of reflect.DeepEqual.
+// It exists purely to show how `reflect.Value` can be used to walk arbitrary
+// values at runtime, recursing through structs, slices, arrays, maps, pointers
+// and interfaces.  Like the stdlib, a nil slice is NOT equal to an empty slice
+// and funcs are only equal when both are nil.
+func DeepEqual(a, b any) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	va, vb := reflect.ValueOf(a), reflect.ValueOf(b)
+	if va.Type() != vb.Type() {
+		return false
+	}
+	return deepValueEqual(va, vb, make(map[visit]bool))
+}
+
+func deepValueEqual(a, b reflect.Value, visited map[visit]bool) bool {
+	if !a.IsValid() || !b.IsValid() {
+		return a.IsValid() == b.IsValid()
+	}
+	if a.Type() != b.Type() {
+		return false
+	}
+
+	// Only reference like kinds can form a cycle, track those we have
+	// already entered so a self referencing value doesn't recurse forever.
+	switch a.Kind() {
+	case reflect.Map, reflect.Slice, reflect.Pointer:
+		if a.IsNil() || b.IsNil() {
+			return a.IsNil() == b.IsNil()
+		}
+		v := visit{a: a.Pointer(), b: b.Pointer(), typ: a.Type()}
+		if visited[v] {
+			return true
+		}
+		visited[v] = true
+	}
+
+	switch a.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		return deepValueEqual(a.Elem(), b.Elem(), visited)
+	case reflect.Struct:
+		for i := 0; i < a.NumField(); i++ {
+			if !deepValueEqual(a.Field(i), b.Field(i), visited) {
+				return false
+			}
+		}
+		return true
+	case reflect.Slice, reflect.Array:
+		if a.Len() != b.Len() {
+			return false
+		}
+		for i := 0; i < a.Len(); i++ {
+			if !deepValueEqual(a.Index(i), b.Index(i), visited) {
+				return false
+			}
+		}
+		return true
+	case reflect.Map:
+		if a.Len() != b.Len() {
+			return false
+		}
+		iter := a.MapRange()
+		for iter.Next() {
+			other := b.MapIndex(iter.Key())
+			if !other.IsValid() || !deepValueEqual(iter.Value(), other, visited) {
+				return false
+			}
+		}
+		return true
+	case reflect.Func:
+		return a.IsNil() && b.IsNil()
+	case reflect.Bool:
+		return a.Bool() == b.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return a.Int() == b.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return a.Uint() == b.Uint()
+	case reflect.Float32, reflect.Float64:
+		return a.Float() == b.Float()
+	case reflect.Complex64, reflect.Complex128:
+		return a.Complex() == b.Complex()
+	case reflect.String:
+		return a.String() == b.String()
+	case reflect.Chan, reflect.UnsafePointer:
+		return a.Pointer() == b.Pointer()
+	}
+	return false
+}
@@ -0,0 +1,70 @@
+package reflection_unsafe_go
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestStruct() TestStruct {
+	return TestStruct{
+		a: 1,
+		b: "foo",
+		c: []int{1, 2, 3},
+		D: map[string]int{"one": 1, "two": 2},
+	}
+}
+
+func TestDeepEqualStructs(t *testing.T) {
+	x, y := newTestStruct(), newTestStruct()
+	// Two distinct backing arrays and maps, but equal contents.
+	assert.True(t, DeepEqual(x, y))
+
+	y.c[2] = 100
+	assert.False(t, DeepEqual(x, y))
+
+	y = newTestStruct()
+	y.D["three"] = 3
+	assert.False(t, DeepEqual(x, y))
+
+	y = newTestStruct()
+	y.b = "bar"
+	assert.False(t, DeepEqual(x, y))
+}
+
+func TestDeepEqualNilPointers(t *testing.T) {
+	var x, y *TestStruct
+	assert.True(t, DeepEqual(x, y))
+
+	z := newTestStruct()
+	assert.False(t, DeepEqual(x, &z))
+}
+
+func TestDeepEqualNilVsEmptySlice(t *testing.T) {
+	// Just like reflect.DeepEqual, a nil slice is not an empty slice.
+	var nilSlice []int
+	assert.False(t, DeepEqual(nilSlice, []int{}))
+}
+
+func TestDeepEqualDifferentTypes(t *testing.T) {
+	assert.False(t, DeepEqual(int32(1), int64(1)))
+}
+
+type node struct {
+	value int
+	next  *node
+}
+
+func TestDeepEqualCycles(t *testing.T) {
+	// Two separate, self referencing loops.  Without cycle detection
+	// walking these would never terminate.
+	a := &node{value: 1}
+	a.next = a
+	b := &node{value: 1}
+	b.next = b
+	assert.True(t, DeepEqual(a, b))
+
+	c := &node{value: 2}
+	c.next = c
+	assert.False(t, DeepEqual(a, c))
+}
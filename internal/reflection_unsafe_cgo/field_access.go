@@ -0,0 +1,18 @@
+package reflection_unsafe_go
+
+import "reflect"
+
+// GetFieldDirect reads a field through get, an ordinary accessor such as
+// func(s T) F { return s.Field }.  The field is resolved at compile time and
+// the call is typically inlined away entirely.  See the field access
+// benchmarks for how it compares to GetFieldReflect.
+func GetFieldDirect[S, F any](s S, get func(S) F) F {
+	return get(s)
+}
+
+// GetFieldReflect reads the field called name from the struct s at runtime.
+// It panics if s is not a struct or the field is missing or unexported, see
+// GetByPath for a version that returns errors instead.
+func GetFieldReflect(s any, name string) any {
+	return reflect.ValueOf(s).FieldByName(name).Interface()
+}
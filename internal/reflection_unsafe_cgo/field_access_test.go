@@ -0,0 +1,21 @@
+package reflection_unsafe_go
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fieldD is the direct accessor for TestStruct.D.
+func fieldD(s TestStruct) map[string]int {
+	return s.D
+}
+
+func TestFieldAccessEquivalent(t *testing.T) {
+	s := TestStruct{D: map[string]int{"foo": 1}}
+	assert.Equal(t, GetFieldDirect(s, fieldD), GetFieldReflect(s, "D"))
+}
+
+func TestGetFieldReflectUnexportedPanics(t *testing.T) {
+	assert.Panics(t, func() { GetFieldReflect(TestStruct{a: 1}, "a") })
+}
@@ -0,0 +1,98 @@
+package reflection_unsafe_go
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+var (
+	// ErrNotStructPointer is returned when the target is not a pointer to a struct.
+	// reflect can only set fields when it has an addressable value, which for
+	// a struct means we must be handed a pointer to it.
+	ErrNotStructPointer = errors.New("target must be a non-nil pointer to a struct")
+	// ErrFieldNotFound is returned when the struct has no field of the given name.
+	ErrFieldNotFound = errors.New("field not found")
+	// ErrUnexportedField is returned when the field exists but is unexported,
+	// reflect will refuse to set (or even read via Interface()) these.
+	ErrUnexportedField = errors.New("field is unexported")
+	// ErrNotStruct is returned when a field is requested from something that
+	// is not a struct (or a pointer to one).
+	ErrNotStruct = errors.New("value is not a struct")
+)
+
+// FieldTypeError is returned when the value being assigned to a field is
+// not assignable to the fields type.
+type FieldTypeError struct {
+	Field string
+	Want  reflect.Type
+	Got   reflect.Type
+}
+
+func (e *FieldTypeError) Error() string {
+	return fmt.Sprintf("cannot assign %v to field %q of type %v", e.Got, e.Field, e.Want)
+}
+
+// SetField sets the struct field called name on target to value.  target must
+// be a pointer to a struct, otherwise the field is not addressable and reflect
+// would panic when calling Set.  Unexported fields cannot be set and will return
+// an error rather than panicking.
+func SetField(target any, name string, value any) error {
+	ptr := reflect.ValueOf(target)
+	if ptr.Kind() != reflect.Pointer || ptr.IsNil() || ptr.Elem().Kind() != reflect.Struct {
+		return ErrNotStructPointer
+	}
+	structValue := ptr.Elem()
+	field, ok := structValue.Type().FieldByName(name)
+	if !ok {
+		return fmt.Errorf("%w: %q", ErrFieldNotFound, name)
+	}
+	if !field.IsExported() {
+		return fmt.Errorf("%w: %q", ErrUnexportedField, name)
+	}
+	fieldValue := structValue.FieldByIndex(field.Index)
+	newValue := reflect.ValueOf(value)
+	if !newValue.IsValid() {
+		// An untyped nil, only assign the zero value if the field can hold nil.
+		switch field.Type.Kind() {
+		case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Pointer, reflect.Slice:
+			fieldValue.SetZero()
+			return nil
+		}
+		return &FieldTypeError{Field: name, Want: field.Type, Got: nil}
+	}
+	if !newValue.Type().AssignableTo(field.Type) {
+		return &FieldTypeError{Field: name, Want: field.Type, Got: newValue.Type()}
+	}
+	fieldValue.Set(newValue)
+	return nil
+}
+
+// GetByPath resolves a dotted path such as "Address.Street" against v, walking
+// through nested structs (and pointers to structs) one segment at a time.
+// Every segment must name an exported field, as the leaf is returned via
+// Interface() which reflect does not permit for unexported fields.
+func GetByPath(v any, path string) (any, error) {
+	current := reflect.ValueOf(v)
+	for _, segment := range strings.Split(path, ".") {
+		for current.Kind() == reflect.Pointer {
+			if current.IsNil() {
+				return nil, fmt.Errorf("%w: nil pointer before %q", ErrNotStruct, segment)
+			}
+			current = current.Elem()
+		}
+		if current.Kind() != reflect.Struct {
+			return nil, fmt.Errorf("%w: cannot resolve %q on %v", ErrNotStruct, segment, current.Kind())
+		}
+		field, ok := current.Type().FieldByName(segment)
+		if !ok {
+			return nil, fmt.Errorf("%w: %q in path %q", ErrFieldNotFound, segment, path)
+		}
+		if !field.IsExported() {
+			return nil, fmt.Errorf("%w: %q in path %q", ErrUnexportedField, segment, path)
+		}
+		current = current.FieldByIndex(field.Index)
+	}
+	return current.Interface(), nil
+}
@@ -0,0 +1,89 @@
+package reflection_unsafe_go
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetFieldExported(t *testing.T) {
+	s := TestStruct{}
+	err := SetField(&s, "D", map[string]int{"foo": 1})
+	assert.NoError(t, err)
+	assert.Equal(t, s.D, map[string]int{"foo": 1})
+}
+
+func TestSetFieldUnexported(t *testing.T) {
+	s := TestStruct{a: 10}
+	err := SetField(&s, "a", 100)
+	assert.ErrorIs(t, err, ErrUnexportedField)
+	// The original value is untouched.
+	assert.Equal(t, s.a, 10)
+}
+
+func TestSetFieldTypeMismatch(t *testing.T) {
+	s := TestStruct{}
+	err := SetField(&s, "D", "not a map")
+	var typeErr *FieldTypeError
+	assert.True(t, errors.As(err, &typeErr))
+	assert.Equal(t, typeErr.Field, "D")
+	assert.Equal(t, typeErr.Want, reflect.TypeOf(map[string]int{}))
+	assert.Equal(t, typeErr.Got, reflect.TypeOf(""))
+	assert.Nil(t, s.D)
+}
+
+func TestSetFieldRequiresPointer(t *testing.T) {
+	// Passing the struct by value hands reflect a copy, which is not addressable.
+	assert.ErrorIs(t, SetField(TestStruct{}, "D", map[string]int{}), ErrNotStructPointer)
+	assert.ErrorIs(t, SetField((*TestStruct)(nil), "D", map[string]int{}), ErrNotStructPointer)
+}
+
+func TestSetFieldMissing(t *testing.T) {
+	assert.ErrorIs(t, SetField(&TestStruct{}, "Missing", 1), ErrFieldNotFound)
+}
+
+type Address struct {
+	Street   string
+	postcode string
+}
+
+type Person struct {
+	Name    string
+	Address Address
+	Manager *Person
+}
+
+func TestGetByPath(t *testing.T) {
+	p := Person{Name: "Alice", Address: Address{Street: "Baker Street", postcode: "NW1"}}
+	street, err := GetByPath(p, "Address.Street")
+	assert.NoError(t, err)
+	assert.Equal(t, street, "Baker Street")
+
+	// Pointers are followed along the way.
+	boss := &Person{Name: "Bob", Manager: &p}
+	name, err := GetByPath(boss, "Manager.Name")
+	assert.NoError(t, err)
+	assert.Equal(t, name, "Alice")
+
+	// The leaf can itself be a struct.
+	address, err := GetByPath(p, "Address")
+	assert.NoError(t, err)
+	assert.Equal(t, address, p.Address)
+}
+
+func TestGetByPathErrors(t *testing.T) {
+	p := Person{}
+	_, err := GetByPath(p, "Address.Missing")
+	assert.ErrorIs(t, err, ErrFieldNotFound)
+
+	_, err = GetByPath(p, "Address.postcode")
+	assert.ErrorIs(t, err, ErrUnexportedField)
+
+	_, err = GetByPath(p, "Name.Length")
+	assert.ErrorIs(t, err, ErrNotStruct)
+
+	_, err = GetByPath(p, "Manager.Name")
+	assert.ErrorIs(t, err, ErrNotStruct)
+}
@@ -0,0 +1,48 @@
+package reflection_unsafe_go
+
+import "reflect"
+
+// KindTree walks the type of v depth first, recording the Kind of every type
+// it encounters.  Composite kinds are descended into in the following order:
+//
+//   - Struct: each field, in declaration order
+//   - Slice, Array, Pointer, Chan: the element type
+//   - Map: the key type, followed by the value type
+//
+// Walking the type (rather than the contents) means an empty slice still
+// reports the Kind of its elements, the output is stable regardless of the
+// data inside v.  For the TestStruct this gives:
+//
+//	Struct, Int, String, Slice, Int, Map, String, Int
+//
+// Recursive types (a struct holding a pointer to itself) are only expanded
+// once, on the second visit just the Kind is recorded.  A nil v yields nil.
+func KindTree(v any) []reflect.Kind {
+	if v == nil {
+		return nil
+	}
+	var kinds []reflect.Kind
+	walkKinds(reflect.TypeOf(v), &kinds, make(map[reflect.Type]bool))
+	return kinds
+}
+
+func walkKinds(t reflect.Type, kinds *[]reflect.Kind, active map[reflect.Type]bool) {
+	*kinds = append(*kinds, t.Kind())
+	if active[t] {
+		return
+	}
+	active[t] = true
+	defer delete(active, t)
+
+	switch t.Kind() {
+	case reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			walkKinds(t.Field(i).Type, kinds, active)
+		}
+	case reflect.Slice, reflect.Array, reflect.Pointer, reflect.Chan:
+		walkKinds(t.Elem(), kinds, active)
+	case reflect.Map:
+		walkKinds(t.Key(), kinds, active)
+		walkKinds(t.Elem(), kinds, active)
+	}
+}
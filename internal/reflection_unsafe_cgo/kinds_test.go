@@ -0,0 +1,38 @@
+package reflection_unsafe_go
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKindTreeTestStruct(t *testing.T) {
+	expected := []reflect.Kind{
+		reflect.Struct,
+		reflect.Int,    // a
+		reflect.String, // b
+		reflect.Slice,  // c
+		reflect.Int,    // c's elements
+		reflect.Map,    // D
+		reflect.String, // D's keys
+		reflect.Int,    // D's values
+	}
+	assert.Equal(t, KindTree(TestStruct{}), expected)
+	// The contents don't matter, only the shape of the type.
+	assert.Equal(t, KindTree(TestStruct{c: []int{1, 2, 3}, D: map[string]int{"a": 1}}), expected)
+}
+
+func TestKindTreeScalarsAndPointers(t *testing.T) {
+	assert.Equal(t, KindTree(1), []reflect.Kind{reflect.Int})
+	x := "foo"
+	assert.Equal(t, KindTree(&x), []reflect.Kind{reflect.Pointer, reflect.String})
+	assert.Equal(t, KindTree([2][]bool{}), []reflect.Kind{reflect.Array, reflect.Slice, reflect.Bool})
+	assert.Nil(t, KindTree(nil))
+}
+
+func TestKindTreeRecursiveType(t *testing.T) {
+	// node holds a *node, it is only expanded once.
+	expected := []reflect.Kind{reflect.Struct, reflect.Int, reflect.Pointer, reflect.Struct}
+	assert.Equal(t, KindTree(node{}), expected)
+}
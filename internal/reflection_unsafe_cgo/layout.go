@@ -0,0 +1,43 @@
+package reflection_unsafe_go
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// SizeAndAlign reports how many bytes a value of v's type occupies and the
+// alignment it requires in memory.  unsafe.Sizeof and unsafe.Alignof give the
+// same answers but are evaluated at compile time against a static type, for a
+// value only known at runtime as an `any` its reflect.Type is asked instead.
+// Both depend on the platform, an int or pointer is 8 bytes on 64 bit
+// architectures but 4 on 32 bit ones.  A nil v has no type and reports 0, 0.
+func SizeAndAlign(v any) (size, align uintptr) {
+	t := reflect.TypeOf(v)
+	if t == nil {
+		return 0, 0
+	}
+	return t.Size(), uintptr(t.Align())
+}
+
+// FieldOffsets returns the byte offset of every exported field from the start
+// of the struct v (or the struct v points to).  The offsets expose padding,
+// the compiler inserts gaps so each field starts on a multiple of its own
+// alignment, meaning field order can change the size of a struct.
+// Unexported fields still take up space but are left out of the result.
+func FieldOffsets(v any) (map[string]uintptr, error) {
+	t := reflect.TypeOf(v)
+	for t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("%w: %v", ErrNotStruct, t)
+	}
+	offsets := make(map[string]uintptr, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.IsExported() {
+			offsets[field.Name] = field.Offset
+		}
+	}
+	return offsets, nil
+}
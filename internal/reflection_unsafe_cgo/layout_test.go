@@ -0,0 +1,87 @@
+package reflection_unsafe_go
+
+import (
+	"testing"
+	"unsafe"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// The sizes asserted below assume a 64 bit platform such as amd64 or arm64.
+
+type padded struct {
+	a bool
+	b int64
+	c bool
+}
+
+func TestSizeAndAlign(t *testing.T) {
+	size, align := SizeAndAlign(int8(1))
+	assert.Equal(t, size, uintptr(1))
+	assert.Equal(t, align, uintptr(1))
+
+	size, align = SizeAndAlign(int64(1))
+	assert.Equal(t, size, uintptr(8))
+	assert.Equal(t, align, uintptr(8))
+
+	// 1 byte + 7 padding + 8 + 1 + 7 trailing padding, so arrays of padded
+	// keep every b aligned to 8 bytes.
+	size, align = SizeAndAlign(padded{})
+	assert.Equal(t, size, uintptr(24))
+	assert.Equal(t, align, uintptr(8))
+}
+
+func TestSizeAndAlignMatchesUnsafe(t *testing.T) {
+	var s string
+	size, align := SizeAndAlign(s)
+	assert.Equal(t, size, unsafe.Sizeof(s))
+	assert.Equal(t, align, unsafe.Alignof(s))
+
+	var p padded
+	size, align = SizeAndAlign(p)
+	assert.Equal(t, size, unsafe.Sizeof(p))
+	assert.Equal(t, align, unsafe.Alignof(p))
+}
+
+func TestSizeAndAlignNil(t *testing.T) {
+	size, align := SizeAndAlign(nil)
+	assert.Zero(t, size)
+	assert.Zero(t, align)
+}
+
+type mixedLayout struct {
+	A bool
+	B int64
+	C bool
+	D int32
+	E int16
+	f bool
+}
+
+func TestFieldOffsets(t *testing.T) {
+	offsets, err := FieldOffsets(mixedLayout{})
+	assert.NoError(t, err)
+	assert.Equal(t, offsets, map[string]uintptr{
+		"A": 0,
+		// 7 bytes of padding after A so B is 8 byte aligned.
+		"B": 8,
+		"C": 16,
+		// 3 bytes of padding after C so D is 4 byte aligned.
+		"D": 20,
+		"E": 24,
+	})
+	assert.Equal(t, offsets["D"], unsafe.Offsetof(mixedLayout{}.D))
+
+	// Pointers to structs are followed.
+	fromPointer, err := FieldOffsets(&mixedLayout{})
+	assert.NoError(t, err)
+	assert.Equal(t, fromPointer, offsets)
+}
+
+func TestFieldOffsetsNotStruct(t *testing.T) {
+	_, err := FieldOffsets(42)
+	assert.ErrorIs(t, err, ErrNotStruct)
+
+	_, err = FieldOffsets(nil)
+	assert.ErrorIs(t, err, ErrNotStruct)
+}
@@ -0,0 +1,24 @@
+package reflection_unsafe_go
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// ErrCannotInterface is returned by SafeInterface when the value cannot be
+// converted back to an interface.
+var ErrCannotInterface = errors.New("cannot interface value")
+
+// SafeInterface calls v.Interface(), recovering if it panics and returning the
+// panic as an error instead.  Interface() panics when v was obtained through an
+// unexported struct field (or is the zero Value), which makes walking every
+// field of an arbitrary struct a minefield without a guard like this.
+func SafeInterface(v reflect.Value) (value any, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%w: %v", ErrCannotInterface, r)
+		}
+	}()
+	return v.Interface(), nil
+}
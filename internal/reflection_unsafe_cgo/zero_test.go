@@ -0,0 +1,33 @@
+package reflection_unsafe_go
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestZeroValue(t *testing.T) {
+	assert.Equal(t, ZeroValue[int](), 0)
+	assert.Equal(t, ZeroValue[string](), "")
+	assert.Nil(t, ZeroValue[*int]())
+	assert.Equal(t, ZeroValue[TestStruct](), TestStruct{})
+}
+
+func TestZeroOfStruct(t *testing.T) {
+	zero := ZeroOf(reflect.TypeOf(TestStruct{}))
+	s, ok := zero.(TestStruct)
+	assert.True(t, ok)
+	assert.Zero(t, s.a)
+	assert.Empty(t, s.b)
+	assert.Nil(t, s.c)
+	assert.Nil(t, s.D)
+}
+
+func TestZeroOfBuiltins(t *testing.T) {
+	assert.Equal(t, ZeroOf(reflect.TypeOf(0)), 0)
+	assert.Equal(t, ZeroOf(reflect.TypeOf("foo")), "")
+	assert.Equal(t, ZeroOf(reflect.TypeOf(1.5)), 0.0)
+	assert.Equal(t, ZeroOf(reflect.TypeOf([]int{1})), []int(nil))
+	assert.Nil(t, ZeroOf(nil))
+}
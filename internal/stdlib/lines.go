@@ -0,0 +1,25 @@
+package stdlib
+
+import (
+	"bufio"
+	"io"
+)
+
+// maxLineSize caps the length of a single line ReadLines will accept.  A
+// bufio.Scanner defaults to bufio.MaxScanTokenSize (64KiB) and fails with
+// bufio.ErrTooLong on anything bigger.
+const maxLineSize = 1024 * 1024
+
+// ReadLines reads r to completion and returns its lines without their line
+// endings.  bufio.ScanLines strips both "\n" and "\r\n" endings, so files
+// written on windows split the same way.  Empty input yields an empty, non
+// nil slice.
+func ReadLines(r io.Reader) ([]string, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), maxLineSize)
+	lines := []string{}
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	return lines, scanner.Err()
+}
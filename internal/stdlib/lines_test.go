@@ -0,0 +1,34 @@
+package stdlib
+
+import (
+	"bufio"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadLines(t *testing.T) {
+	lines, err := ReadLines(strings.NewReader("one\r\ntwo\nthree\r\n\nfive"))
+	assert.NoError(t, err)
+	assert.Equal(t, lines, []string{"one", "two", "three", "", "five"})
+}
+
+func TestReadLinesEmpty(t *testing.T) {
+	lines, err := ReadLines(strings.NewReader(""))
+	assert.NoError(t, err)
+	assert.NotNil(t, lines)
+	assert.Empty(t, lines)
+}
+
+func TestReadLinesLongLine(t *testing.T) {
+	long := strings.Repeat("x", bufio.MaxScanTokenSize*2)
+	lines, err := ReadLines(strings.NewReader(long + "\nshort\n"))
+	assert.NoError(t, err)
+	assert.Equal(t, lines, []string{long, "short"})
+}
+
+func TestReadLinesTooLong(t *testing.T) {
+	_, err := ReadLines(strings.NewReader(strings.Repeat("x", maxLineSize+1)))
+	assert.ErrorIs(t, err, bufio.ErrTooLong)
+}
@@ -0,0 +1,29 @@
+package stdlib
+
+import "slices"
+
+// SortByField sorts in place using less, in the familiar sort.Slice style.
+// slices.SortFunc instead wants a three way comparison returning a negative,
+// zero or positive int, so less is adapted by asking it both ways round.
+// The sort is not stable, callers wanting a deterministic order for equal
+// elements should break ties inside less.
+func SortByField[T any](in []T, less func(a, b T) bool) {
+	slices.SortFunc(in, func(a, b T) int {
+		switch {
+		case less(a, b):
+			return -1
+		case less(b, a):
+			return 1
+		}
+		return 0
+	})
+}
+
+// ByAgeThenName orders people youngest first, falling back to their name
+// when two people share the same age.
+func ByAgeThenName(a, b Person) bool {
+	if a.Age != b.Age {
+		return a.Age < b.Age
+	}
+	return a.Name < b.Name
+}
@@ -0,0 +1,34 @@
+package stdlib
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSortByFieldAgeThenName(t *testing.T) {
+	people := []Person{
+		{Name: "Dave", Age: 40},
+		{Name: "Carol", Age: 30},
+		{Name: "Alice", Age: 30},
+		{Name: "Bob", Age: 25},
+		{Name: "Aaron", Age: 40},
+	}
+	SortByField(people, ByAgeThenName)
+	assert.Equal(t, people, []Person{
+		{Name: "Bob", Age: 25},
+		{Name: "Alice", Age: 30},
+		{Name: "Carol", Age: 30},
+		{Name: "Aaron", Age: 40},
+		{Name: "Dave", Age: 40},
+	})
+}
+
+func TestSortByFieldMutatesInPlace(t *testing.T) {
+	original := []int{3, 1, 2}
+	alias := original[:]
+	SortByField(original, func(a, b int) bool { return a < b })
+	// No new slice is returned, the shared backing array was sorted.
+	assert.Equal(t, alias, []int{1, 2, 3})
+	assert.Same(t, &alias[0], &original[0])
+}
@@ -0,0 +1,39 @@
+package stdlib
+
+import (
+	"encoding/json"
+
+	"github.com/symonk/learning-go-book/internal/common"
+)
+
+/*
+Go ships with a large, batteries included standard library.  Everything from
+JSON encoding, to time handling, sorting and buffered I/O is available without
+any third party dependencies.
+*/
+
+// InitStdlib announces the standard library chapter.
+func InitStdlib() {
+	common.AnnounceChapter("The Standard Library")
+}
+
+// Person is tagged for encoding/json.  The tag controls the key used in the
+// JSON object, and the omitempty option drops the key entirely when the field
+// holds its zero value.  Struct tags are read at runtime through reflect.
+type Person struct {
+	Name  string `json:"name"`
+	Age   int    `json:"age"`
+	Email string `json:"email,omitempty"`
+}
+
+// ToJSON encodes p as JSON.
+func ToJSON(p Person) ([]byte, error) {
+	return json.Marshal(p)
+}
+
+// FromJSON decodes a Person from JSON.
+func FromJSON(data []byte) (Person, error) {
+	var p Person
+	err := json.Unmarshal(data, &p)
+	return p, err
+}
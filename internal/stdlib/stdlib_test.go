@@ -0,0 +1,31 @@
+package stdlib
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJSONRoundTrip(t *testing.T) {
+	p := Person{Name: "Alice", Age: 30, Email: "alice@example.com"}
+	data, err := ToJSON(p)
+	assert.NoError(t, err)
+	assert.JSONEq(t, string(data), `{"name":"Alice","age":30,"email":"alice@example.com"}`)
+
+	decoded, err := FromJSON(data)
+	assert.NoError(t, err)
+	assert.Equal(t, decoded, p)
+}
+
+func TestJSONOmitEmpty(t *testing.T) {
+	data, err := ToJSON(Person{Name: "Bob", Age: 0})
+	assert.NoError(t, err)
+	// Email is empty so is omitted, Age has no omitempty so stays despite being 0.
+	assert.Equal(t, string(data), `{"name":"Bob","age":0}`)
+	assert.NotContains(t, string(data), "email")
+}
+
+func TestFromJSONInvalid(t *testing.T) {
+	_, err := FromJSON([]byte(`{"name": 1}`))
+	assert.Error(t, err)
+}
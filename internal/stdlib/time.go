@@ -0,0 +1,47 @@
+package stdlib
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// FormatDuration renders d as hours, minutes and seconds, leaving out any
+// zero components, so 1h0m3s becomes "1h3s" and exactly one hour is just "1h".
+// This differs from d.String(), which always includes the smaller units.
+// Durations under a second fall back to d.String() (e.g. "250ms"), for longer
+// durations any fraction of a second is truncated.
+func FormatDuration(d time.Duration) string {
+	if d > -time.Second && d < time.Second {
+		return d.String()
+	}
+	// Work on the magnitude as a uint64.  Negating d directly would overflow
+	// for math.MinInt64, which has no positive int64 counterpart.
+	var b strings.Builder
+	magnitude := uint64(d)
+	if d < 0 {
+		b.WriteByte('-')
+		magnitude = uint64(-(d + 1)) + 1
+	}
+	hours := magnitude / uint64(time.Hour)
+	minutes := magnitude % uint64(time.Hour) / uint64(time.Minute)
+	seconds := magnitude % uint64(time.Minute) / uint64(time.Second)
+
+	for _, part := range []struct {
+		value uint64
+		unit  string
+	}{{hours, "h"}, {minutes, "m"}, {seconds, "s"}} {
+		if part.value > 0 {
+			b.WriteString(strconv.FormatUint(part.value, 10))
+			b.WriteString(part.unit)
+		}
+	}
+	return b.String()
+}
+
+// ParseUnixSeconds converts seconds since the unix epoch into a UTC time.
+// time.Unix returns a time in the local timezone, which would make the result
+// depend on the machine it runs on.
+func ParseUnixSeconds(sec int64) time.Time {
+	return time.Unix(sec, 0).UTC()
+}
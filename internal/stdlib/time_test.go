@@ -0,0 +1,46 @@
+package stdlib
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatDuration(t *testing.T) {
+	assert.Equal(t, FormatDuration(time.Hour+2*time.Minute+3*time.Second), "1h2m3s")
+	assert.Equal(t, FormatDuration(time.Hour+3*time.Second), "1h3s")
+	assert.Equal(t, FormatDuration(90*time.Second), "1m30s")
+	assert.Equal(t, FormatDuration(-90*time.Second), "-1m30s")
+	assert.Equal(t, FormatDuration(26*time.Hour), "26h")
+	assert.Equal(t, FormatDuration(-250*time.Millisecond), "-250ms")
+}
+
+func TestFormatDurationExtremes(t *testing.T) {
+	// -math.MinInt64 overflows an int64, the magnitude must be taken safely.
+	assert.Equal(t, FormatDuration(math.MinInt64), "-2562047h47m16s")
+	assert.Equal(t, FormatDuration(math.MaxInt64), "2562047h47m16s")
+}
+
+func TestFormatDurationExactHours(t *testing.T) {
+	assert.Equal(t, FormatDuration(time.Hour), "1h")
+	assert.Equal(t, FormatDuration(3*time.Hour), "3h")
+	// Compare with the stdlib formatting.
+	assert.Equal(t, (3 * time.Hour).String(), "3h0m0s")
+}
+
+func TestFormatDurationSubSecond(t *testing.T) {
+	assert.Equal(t, FormatDuration(250*time.Millisecond), "250ms")
+	assert.Equal(t, FormatDuration(1500*time.Microsecond), "1.5ms")
+	assert.Equal(t, FormatDuration(0), "0s")
+	// Above a second, the fraction is truncated.
+	assert.Equal(t, FormatDuration(2*time.Second+999*time.Millisecond), "2s")
+}
+
+func TestParseUnixSeconds(t *testing.T) {
+	assert.Equal(t, ParseUnixSeconds(0), time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC))
+	parsed := ParseUnixSeconds(1700000000)
+	assert.Equal(t, parsed, time.Date(2023, 11, 14, 22, 13, 20, 0, time.UTC))
+	assert.Equal(t, parsed.Location(), time.UTC)
+}
@@ -0,0 +1,28 @@
+package testingkit
+
+import (
+	"reflect"
+	"testing"
+)
+
+// RunCases runs fn against every named case as its own subtest, failing the
+// subtest when fn(In) is not deeply equal to Want.  This is the table driven
+// pattern used throughout the repo, naming each case means a failure reports
+// exactly which row broke, and a single row can be run with
+// -run 'TestName/case_name'.  Map iteration order is random, so cases must not
+// depend on one another.
+func RunCases[I, O any](t *testing.T, cases map[string]struct {
+	In   I
+	Want O
+}, fn func(I) O) {
+	t.Helper()
+	for name, c := range cases {
+		c := c
+		t.Run(name, func(t *testing.T) {
+			t.Helper()
+			if got := fn(c.In); !reflect.DeepEqual(got, c.Want) {
+				t.Errorf("fn(%v) = %v, want %v", c.In, got, c.Want)
+			}
+		})
+	}
+}
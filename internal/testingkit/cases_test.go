@@ -0,0 +1,63 @@
+package testingkit
+
+import (
+	"os"
+	"os/exec"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Double(n int) int {
+	return n * 2
+}
+
+func TestRunCasesDouble(t *testing.T) {
+	RunCases(t, map[string]struct {
+		In   int
+		Want int
+	}{
+		"zero":     {In: 0, Want: 0},
+		"positive": {In: 4, Want: 8},
+		"negative": {In: -3, Want: -6},
+	}, Double)
+}
+
+func TestRunCasesSlices(t *testing.T) {
+	// Outputs are compared with reflect.DeepEqual, so slices work too.
+	RunCases(t, map[string]struct {
+		In   []int
+		Want []int
+	}{
+		"empty": {In: []int{}, Want: []int{}},
+		"some":  {In: []int{1, 2}, Want: []int{2, 4}},
+	}, func(in []int) []int {
+		out := make([]int, len(in))
+		for i, v := range in {
+			out[i] = Double(v)
+		}
+		return out
+	})
+}
+
+// TestRunCasesFailure re-runs the test binary so a deliberately wrong case can
+// fail inside its own *testing.T without failing this test.
+func TestRunCasesFailure(t *testing.T) {
+	if os.Getenv("RUN_CASES_FAILURE") == "1" {
+		RunCases(t, map[string]struct {
+			In   int
+			Want int
+		}{
+			"correct": {In: 2, Want: 4},
+			"wrong":   {In: 2, Want: 5},
+		}, Double)
+		return
+	}
+	cmd := exec.Command(os.Args[0], "-test.run=^TestRunCasesFailure$", "-test.v")
+	cmd.Env = append(os.Environ(), "RUN_CASES_FAILURE=1")
+	output, err := cmd.CombinedOutput()
+	assert.Error(t, err)
+	assert.Contains(t, string(output), "--- PASS: TestRunCasesFailure/correct")
+	assert.Contains(t, string(output), "--- FAIL: TestRunCasesFailure/wrong")
+	assert.Contains(t, string(output), "fn(2) = 4, want 5")
+}
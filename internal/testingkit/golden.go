@@ -0,0 +1,37 @@
+package testingkit
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// update is registered on the test binary of any package importing testingkit,
+// so golden files can be regenerated with `go test ./... -update`.
+var update = flag.Bool("update", false, "rewrite golden files with the current output")
+
+// AssertGolden compares got against the contents of the golden file at
+// goldenPath, failing the test when they differ.  Golden files hold the
+// expected output of something too large to comfortably inline in a test,
+// when the output changes on purpose, running with -update rewrites the file
+// with got (creating any missing directories) instead of comparing.
+func AssertGolden(t *testing.T, got []byte, goldenPath string) {
+	t.Helper()
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(goldenPath), 0o755); err != nil {
+			t.Fatalf("creating golden directory: %v", err)
+		}
+		if err := os.WriteFile(goldenPath, got, 0o644); err != nil {
+			t.Fatalf("updating golden file: %v", err)
+		}
+		return
+	}
+	want, err := os.ReadFile(goldenPath)
+	if err != nil {
+		t.Fatalf("reading golden file (run with -update to create it): %v", err)
+	}
+	if string(got) != string(want) {
+		t.Errorf("output does not match golden file %s\ngot:\n%s\nwant:\n%s", goldenPath, got, want)
+	}
+}
@@ -0,0 +1,49 @@
+package testingkit
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// withUpdate flips the -update flag for the duration of a test.
+func withUpdate(t *testing.T, value bool) {
+	previous := *update
+	*update = value
+	t.Cleanup(func() { *update = previous })
+}
+
+func TestAssertGoldenWriteThenVerify(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "testdata", "output.golden")
+
+	withUpdate(t, true)
+	AssertGolden(t, []byte("hello\ngolden\n"), path)
+	written, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Equal(t, string(written), "hello\ngolden\n")
+
+	*update = false
+	AssertGolden(t, []byte("hello\ngolden\n"), path)
+}
+
+// TestAssertGoldenMismatch re-runs the test binary to execute a test which is
+// expected to fail, a failing test cannot be observed from inside the same *testing.T.
+func TestAssertGoldenMismatch(t *testing.T) {
+	if path := os.Getenv("GOLDEN_MISMATCH_PATH"); path != "" {
+		AssertGolden(t, []byte("actual"), path)
+		return
+	}
+	path := filepath.Join(t.TempDir(), "mismatch.golden")
+	assert.NoError(t, os.WriteFile(path, []byte("expected"), 0o644))
+
+	cmd := exec.Command(os.Args[0], "-test.run=^TestAssertGoldenMismatch$")
+	cmd.Env = append(os.Environ(), "GOLDEN_MISMATCH_PATH="+path)
+	output, err := cmd.CombinedOutput()
+	assert.Error(t, err)
+	assert.Contains(t, string(output), "output does not match golden file")
+	// t.Helper() attributes the failure to the calling test, not golden.go.
+	assert.Contains(t, string(output), "golden_test.go")
+}
@@ -0,0 +1,39 @@
+package testingkit
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+// maxPropertyLength bounds the length of the slices generated by ForAll.
+const maxPropertyLength = 32
+
+// RandomSlice returns n ints in the range [-100, 100] generated from seed.
+// The same seed always produces the same slice, so a failing input can be
+// reproduced from the seed alone.  Each call uses its own rand.Rand rather
+// than the shared global source, making it safe to call from parallel tests.
+func RandomSlice(n int, seed int64) []int {
+	r := rand.New(rand.NewSource(seed))
+	out := make([]int, n)
+	for i := range out {
+		out[i] = r.Intn(201) - 100
+	}
+	return out
+}
+
+// ForAll checks property against iterations generated slices of varying length,
+// a lightweight take on property based testing.  The first input for which
+// property returns false fails the test, reporting the input and the seed
+// that produced it.  property is handed a copy, so mutating it does not
+// affect the reported counterexample.
+func ForAll(t *testing.T, iterations int, property func([]int) bool) {
+	t.Helper()
+	for i := 0; i < iterations; i++ {
+		seed := int64(i)
+		input := RandomSlice(i%(maxPropertyLength+1), seed)
+		if !property(slices.Clone(input)) {
+			t.Fatalf("property failed for input %v (seed %d)", input, seed)
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package testingkit
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRandomSliceDeterministic(t *testing.T) {
+	assert.Equal(t, RandomSlice(10, 42), RandomSlice(10, 42))
+	assert.NotEqual(t, RandomSlice(10, 1), RandomSlice(10, 2))
+	assert.Len(t, RandomSlice(5, 0), 5)
+	for _, v := range RandomSlice(100, 7) {
+		assert.True(t, v >= -100 && v <= 100)
+	}
+}
+
+func TestForAllReverseTwiceIsIdentity(t *testing.T) {
+	ForAll(t, 200, func(s []int) bool {
+		reversed := slices.Clone(s)
+		slices.Reverse(reversed)
+		slices.Reverse(reversed)
+		return slices.Equal(reversed, s)
+	})
+}
+
+// TestForAllCounterexample re-runs the test binary to check a false property
+// fails and reports the offending input.
+func TestForAllCounterexample(t *testing.T) {
+	if os.Getenv("FOR_ALL_COUNTEREXAMPLE") == "1" {
+		// Not true, reversing once only preserves palindromes.
+		ForAll(t, 200, func(s []int) bool {
+			reversed := slices.Clone(s)
+			slices.Reverse(reversed)
+			return slices.Equal(reversed, s)
+		})
+		return
+	}
+	cmd := exec.Command(os.Args[0], "-test.run=^TestForAllCounterexample$")
+	cmd.Env = append(os.Environ(), "FOR_ALL_COUNTEREXAMPLE=1")
+	output, err := cmd.CombinedOutput()
+	assert.Error(t, err)
+	// Lengths 0 and 1 are palindromes, the first failure is the two element slice.
+	assert.Contains(t, string(output), "property failed for input "+fmt.Sprint(RandomSlice(2, 2))+" (seed 2)")
+}
@@ -0,0 +1,15 @@
+package testingkit
+
+import "github.com/symonk/learning-go-book/internal/common"
+
+/*
+Go's testing package is deliberately small, there are no assertion functions
+or fixtures built in.  Instead common patterns are written as plain helper
+functions taking a *testing.T.  Calling t.Helper() inside a helper makes
+failures report the line in the calling test, rather than the helper itself.
+*/
+
+// InitTesting announces the testing techniques chapter.
+func InitTesting() {
+	common.AnnounceChapter("Testing Techniques")
+}